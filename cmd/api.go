@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/vectorman1/analysis/analysis-api/common"
 	"github.com/vectorman1/analysis/analysis-api/db"
+	"github.com/vectorman1/analysis/analysis-api/middleware"
 	logger_grpc "github.com/vectorman1/analysis/analysis-api/middleware/logger-grpc"
 	grpc_server "github.com/vectorman1/analysis/analysis-api/server/grpc-server"
 	rest_server "github.com/vectorman1/analysis/analysis-api/server/rest-server"
@@ -76,6 +78,11 @@ func RunServer() error {
 	}
 	defer client.Disconnect(tctx)
 
+	// documents are migrated first, as indexes may require them to be unique
+	err = db.MigrateMongo(client.Database(common.MongoDbDatabase))
+	if err != nil {
+		return fmt.Errorf("failed to migrate mongodb documents: %v", err)
+	}
 	err = db.CreateMongoIndexes(client.Database(common.MongoDbDatabase))
 	if err != nil {
 		return fmt.Errorf("failed to create mongodb indexes: %v", err)
@@ -89,7 +96,7 @@ func RunServer() error {
 		fmt.Println(sig)
 	}()
 
-	s, err := initializeServices(ctx, dbConnPool, client.Database(common.MongoDbDatabase), config)
+	s, metrics, err := initializeServices(ctx, dbConnPool, client.Database(common.MongoDbDatabase), config)
 	if err != nil {
 		return err
 	}
@@ -98,30 +105,81 @@ func RunServer() error {
 	go func() {
 		_ = rest_server.RunServer(ctx, config)
 	}()
+	if len(config.MetricsPort) > 0 {
+		go func() {
+			_ = rest_server.RunMetricsServer(ctx, config, metrics)
+		}()
+	}
 
 	return s.Run()
 }
 
-func initializeServices(ctx context.Context, pgConnPool *pgx.ConnPool, mongoDatabase *mongo.Database, config *common.Config) (*grpc_server.GRPCServer, error) {
+func initializeServices(ctx context.Context, pgConnPool *pgx.ConnPool, mongoDatabase *mongo.Database, config *common.Config) (*grpc_server.GRPCServer, http.Handler, error) {
 	historyRepository := instruments_repo.NewHistoryRepository(mongoDatabase)
 	symbolOverviewRepository := instruments_repo.NewSymbolOverviewRepository(mongoDatabase)
+	fundamentalsRepository := instruments_repo.NewFundamentalsRepository(mongoDatabase)
 
 	symbolRepository := instruments_repo.NewSymbolRepository(pgConnPool)
+	instrumentUploadRepository := instruments_repo.NewInstrumentUploadRepository(pgConnPool)
+	marketRepository := instruments_repo.NewMarketRepository(pgConnPool)
+	tickerMappingRepository := instruments_repo.NewTickerMappingRepository(pgConnPool)
+	securityRepository := instruments_repo.NewSecurityRepository(pgConnPool)
+	providerQuotaRepository := instruments_repo.NewProviderQuotaRepository(pgConnPool)
 	userRepository := user_repo.NewUserRepository(pgConnPool)
+	signingKeyRepository := user_repo.NewSigningKeyRepository(pgConnPool)
+	sessionRepository := user_repo.NewSessionRepository(pgConnPool)
+	apiKeyRepository := user_repo.NewApiKeyRepository(pgConnPool)
+	loginAttemptRepository := user_repo.NewLoginAttemptRepository(pgConnPool)
+	recoveryCodeRepository := user_repo.NewRecoveryCodeRepository(pgConnPool)
+	identityRepository := user_repo.NewIdentityRepository(pgConnPool)
+	oidcLoginRepository := user_repo.NewOidcLoginRepository(pgConnPool)
+	profileRepository := user_repo.NewProfileRepository(pgConnPool)
 
 	trading212Service := instruments_third_party.NewTrading212Service()
-	alphaVantageService := instruments_third_party.NewAlphaVantageService(config)
+	alphaVantageService := instruments_third_party.NewAlphaVantageService(config, providerQuotaRepository)
 	yahooService := instruments_third_party.NewYahooService()
+	instrumentSources, err := instruments_third_party.NewInstrumentSources(config, trading212Service, instrumentUploadRepository)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	reportService := instruments_service.NewReportService()
-	symbolService := instruments_service.NewSymbolService(symbolRepository, symbolOverviewRepository, alphaVantageService, trading212Service)
-	userService := user_service.NewUserService(userRepository, config)
-	historyService := instruments_service.NewHistoryService(yahooService, historyRepository, symbolRepository, symbolOverviewRepository, reportService)
+	marketService := instruments_service.NewMarketService(marketRepository)
+	tickerMappingService := instruments_service.NewTickerMappingService(tickerMappingRepository, symbolRepository, yahooService)
+	overviewRefresher := instruments_service.NewOverviewRefresher(symbolOverviewRepository, fundamentalsRepository, alphaVantageService, tickerMappingService)
+	go overviewRefresher.Run(ctx)
+	symbolService := instruments_service.NewSymbolService(symbolRepository, symbolOverviewRepository, securityRepository, fundamentalsRepository, historyRepository, instrumentUploadRepository, overviewRefresher, instrumentSources, marketService)
+	if err = symbolService.BackfillOverviewIsins(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to key overviews by isin: %v", err)
+	}
+	tokenService, err := user_service.NewTokenService(signingKeyRepository, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = tokenService.Init(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize signing keys: %v", err)
+	}
+	go tokenService.Run(ctx)
+	sessionService := user_service.NewSessionService(sessionRepository, userRepository, tokenService, config)
+	go sessionService.Run(ctx)
+	apiKeyService := user_service.NewApiKeyService(apiKeyRepository, sessionService)
+	loginGuard := user_service.NewLoginGuard(loginAttemptRepository, config)
+	passwordPolicy, err := user_service.NewPasswordPolicy(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	mfaService := user_service.NewMfaService(userRepository, recoveryCodeRepository, tokenService, sessionService, loginGuard, config)
+	oidcService := user_service.NewOidcService(userRepository, identityRepository, oidcLoginRepository, tokenService, sessionService, loginGuard, passwordPolicy, config)
+	profileService := user_service.NewProfileService(profileRepository, userRepository)
+	userService := user_service.NewUserService(userRepository, tokenService, sessionService, apiKeyService, loginGuard, passwordPolicy, mfaService, oidcService, profileService, config)
+	historyService := instruments_service.NewHistoryService(yahooService, historyRepository, symbolRepository, symbolOverviewRepository, reportService, marketService, tickerMappingService, profileService)
 
-	symbolServiceServer := instruments_present.NewSymbolServiceServer(symbolService, historyService)
+	symbolServiceServer := instruments_present.NewSymbolServiceServer(symbolService, historyService, marketService, tickerMappingService)
 	userServiceServer := user_present.NewUserServiceServer(userService)
 
-	return grpc_server.NewGRPCServer(ctx, config.GRPCPort, symbolServiceServer, userServiceServer), nil
+	metrics := instruments_present.NewQuotaMetricsHandler(overviewRefresher)
+
+	return grpc_server.NewGRPCServer(ctx, config.GRPCPort, middleware.NewAuthenticator(tokenService, apiKeyService, sessionService), symbolServiceServer, userServiceServer), metrics, nil
 }
 
 func main() {
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"context"
+
 	"github.com/dgrijalva/jwt-go"
 	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
 )
@@ -8,5 +10,27 @@ import (
 type Claims struct {
 	Uuid        string            `json:"uuid"`
 	PrivateRole model.PrivateRole `json:"privateRole"`
+	// SessionUuid is the session the token was issued for, which can be revoked
+	SessionUuid string `json:"sid,omitempty"`
+	// MustChangePassword limits the token to changing the password chosen by an admin
+	MustChangePassword bool `json:"mcp,omitempty"`
+	// TotpEnrollmentRequired limits the token of an admin to enrolling TOTP when it is required
+	TotpEnrollmentRequired bool `json:"ter,omitempty"`
+	// ApiKeyUuid is set when the request was authenticated with an API key, which may be read-only
+	ApiKeyUuid string `json:"-"`
+	ReadOnly   bool   `json:"-"`
 	jwt.StandardClaims
 }
+
+type claimsContextKey struct{}
+
+// ContextWithClaims returns a copy of the context holding the claims of the request's user
+func ContextWithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of the request's user, if it was authenticated
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*Claims)
+	return claims, ok && claims != nil
+}
@@ -31,12 +31,80 @@ type postgreSQLConfig struct {
 	DatabaseMaxConnections int `json:"database_max_connections"`
 }
 
+type ExchangeListingConfig struct {
+	// Name of the listing, reported as part of the instrument source
+	Name string `json:"name"`
+	// Url of the CSV file with the exchange's listed instruments
+	Url string `json:"url"`
+	// MarketName, CurrencyCode and MarketHoursGmt are set on every instrument of the listing
+	MarketName     string `json:"market_name"`
+	CurrencyCode   string `json:"currency_code"`
+	MarketHoursGmt string `json:"market_hours_gmt"`
+	// Header names of the CSV columns to read the instrument values from
+	IdentifierColumn string `json:"identifier_column"`
+	NameColumn       string `json:"name_column"`
+	IsinColumn       string `json:"isin_column"`
+}
+
 type Config struct {
 	// Alpha Vantage API Key
 	AlphaVantageApiKey string `json:"alpha_vantage_api_key"`
+	// Maximum number of Alpha Vantage requests per minute and per day across all replicas,
+	// default to the free tier's limits
+	AlphaVantageMinuteQuota int `json:"alpha_vantage_minute_quota"`
+	AlphaVantageDailyQuota  int `json:"alpha_vantage_daily_quota"`
 
-	// JWT secret for signing user tokens
+	// JWT secret which verifies user tokens issued before signing keys were rotated, which have no kid
 	JwtSigningSecret string `json:"jwt_signing_secret"`
+	// Algorithm of new signing keys, HS256, RS256 or EdDSA. Defaults to HS256.
+	JwtAlgorithm string `json:"jwt_algorithm"`
+	// Hours after which a new signing key replaces the current one, defaults to 30 days
+	JwtKeyRotationHours int `json:"jwt_key_rotation_hours"`
+	// Audience and issuer of user tokens, which are required to match
+	JwtAudience string `json:"jwt_audience"`
+	JwtIssuer   string `json:"jwt_issuer"`
+	// Minutes access tokens are valid for, defaults to 15
+	AccessTokenMinutes int `json:"access_token_minutes"`
+	// Days a session can go without refreshing its tokens before it expires, defaults to 30
+	RefreshTokenDays int `json:"refresh_token_days"`
+	// Failed logins of a username after which it is locked, defaults to 10
+	LoginMaxFailures int `json:"login_max_failures"`
+	// Failed logins from an address, across usernames, after which it is locked, defaults to 50
+	LoginMaxFailuresPerIp int `json:"login_max_failures_per_ip"`
+	// Minutes a username or address stays locked, defaults to 15
+	LoginLockoutMinutes int `json:"login_lockout_minutes"`
+	// Minimum length of passwords, defaults to 8
+	PasswordMinLength int `json:"password_min_length"`
+	// Number of character classes passwords must contain, out of lower case letters, upper case letters, digits and symbols
+	PasswordMinClasses int `json:"password_min_classes"`
+	// Path of a file of the sorted SHA-1 hashes of breached passwords, one per line, which can't be used
+	BreachedPasswordsFile string `json:"breached_passwords_file"`
+	// Whether admins have to enroll TOTP two-factor authentication before using the API
+	RequireAdminTotp bool `json:"require_admin_totp"`
+	// Issuer shown in authenticator apps, defaults to Analysis
+	TotpIssuer string `json:"totp_issuer"`
+	// OpenID Connect provider users can log in with, which is disabled when no issuer is set
+	OidcIssuerUrl string `json:"oidc_issuer_url"`
+	OidcClientId  string `json:"oidc_client_id"`
+	// Secret of confidential clients, public clients rely on PKCE alone
+	OidcClientSecret string `json:"oidc_client_secret"`
+	// URL the provider redirects to after logins, which passes the code and state on to the callback endpoint
+	OidcRedirectUrl string `json:"oidc_redirect_url"`
+	// Scopes requested along with openid, default to profile and email
+	OidcScopes []string `json:"oidc_scopes"`
+	// Claims usernames and groups are read from, default to preferred_username and groups
+	OidcUsernameClaim string `json:"oidc_username_claim"`
+	OidcGroupsClaim   string `json:"oidc_groups_claim"`
+	// Groups whose members are admins. When set, the roles of users follow their groups on every login,
+	// so users removed from them at the provider keep their role until they log in with it again.
+	OidcAdminGroups []string `json:"oidc_admin_groups"`
+	// Groups whose members can log in, all users of the provider can when empty
+	OidcAllowedGroups []string `json:"oidc_allowed_groups"`
+
+	// Names of the enabled instrument sources, in order of priority when they disagree
+	InstrumentSources []string `json:"instrument_sources"`
+	// CSV listings of exchanges used by the exchange listing source
+	ExchangeListings []ExchangeListingConfig `json:"exchange_listings"`
 
 	// Allowed origins for CORS policy
 	AllowedOrigin string `json:"allowed_origin"`
@@ -48,6 +116,9 @@ type Config struct {
 	// HTTP/REST gateway start parameters section
 	// HTTPPort is TCP port to listen by HTTP/REST gateway
 	HTTPPort string `json:"http_port"`
+	// MetricsPort is TCP port to serve the metrics on, which is kept apart from the gateway so it can
+	// stay unpublished and only be scraped internally. Metrics aren't served when it isn't set.
+	MetricsPort string `json:"metrics_port"`
 
 	MongoDbConnString string           `json:"mongo_db_conn_string"`
 	PostgreSQLConfig  postgreSQLConfig `json:"postgre_sql_config"`
@@ -7,31 +7,54 @@ const Trading212InstrumentsLink = `https://www.trading212.com/en/Trade-Equities`
 const Trading212ShowAllButtonSelector = `div.conditions-table > div > div.view-more > a`
 const Trading212AllInstrumentsSelector = `#all-equities`
 
+// urls of the NASDAQ Trader symbol directory files
+const NasdaqListedLink = `https://www.nasdaqtrader.com/dynamic/SymDir/nasdaqlisted.txt`
+const OtherListedLink = `https://www.nasdaqtrader.com/dynamic/SymDir/otherlisted.txt`
+
 // namespace of symbol uuids in order to reproduce them later
 const SymbolsNamespace = `53edcce7-94d4-4deb-b2ac-d1f6d8657d8e`
 
+// names of the sources the instrument universe is gathered from
+const SourceTrading212 = `trading212`
+const SourceNasdaqTrader = `nasdaq_trader`
+const SourceExchangeListing = `exchange_listing`
+const SourceManual = `manual`
+
+// names of the market data providers instrument tickers are mapped to
+const ProviderYahoo = `yahoo`
+const ProviderAlphaVantage = `alpha_vantage`
+
+// sources of a ticker mapping, in order of precedence
+const TickerMappingManual = `manual`
+const TickerMappingIsin = `isin`
+const TickerMappingRule = `rule`
+
+// ranges charts are shown for when no dates are requested
+const ChartRange1M = `1M`
+const ChartRange3M = `3M`
+const ChartRange6M = `6M`
+const ChartRangeYTD = `YTD`
+const ChartRange1Y = `1Y`
+const ChartRange5Y = `5Y`
+const ChartRangeMax = `MAX`
+const DefaultChartRange = ChartRange1Y
+
+// cookie which binds an OpenID Connect login to the browser which started it, and the response
+// header metadata the gateway sets it from, which clears it when empty
+const OidcBindingCookie = `oidc_binding`
+const OidcBindingMetadata = `x-oidc-binding`
+
 // mongodb related constants
 const MongoDbDatabase = `analysis`
 const OverviewsCollection = `overviews`
+const OverviewSnapshotsCollection = `overview_snapshots`
+const FinancialReportsCollection = `financial_reports`
 const HistoriesCollection = `histories`
-
-// market names, in order to extract only the relevant ones
-const MarketNYSE = `NYSE`
-const MarketNASDAQ = `NASDAQ`
-const MarketNonISANYSE = `NON-ISA NYSE`
-const MarketNonISAOTCMarkets = `NON-ISA OTC Markets`
-
-// constants for random string generation
-const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-const (
-	letterIdxBits = 6                    // 6 bits to represent a letter index
-	letterIdxMask = 1<<letterIdxBits - 1 // All 1-bits, as many as letterIdxBits
-	letterIdxMax  = 63 / letterIdxBits   // # of letter indices fitting in 63 bits
-)
+const MigrationsCollection = `migrations`
 
 // "constant" slice of allowed headers and methdfor CORS config
 func GetAllowedHeaders() []string {
-	return []string{"Authorization", "Accept", "Origin", "DNT", "X-CustomHeader", "Keep-Alive", "User-Agent", "X-Requested-With", "If-Modified-Since", "Cache-Control", "Content-Type", "Content-Range", "Range"}
+	return []string{"Authorization", "X-Api-Key", "Accept", "Origin", "DNT", "X-CustomHeader", "Keep-Alive", "User-Agent", "X-Requested-With", "If-Modified-Since", "Cache-Control", "Content-Type", "Content-Range", "Range"}
 }
 func GetAllowedMethods() []string {
 	return []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions}
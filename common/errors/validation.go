@@ -5,3 +5,8 @@ const InvalidUsernameOrPassword = `Invalid username or password`
 const NoHistoryFoundForSymbol = `No history found for symbol`
 const NoOverviewFoundForSymbol = `No overview found for symbol`
 const NoSymbolFound = `No symbol with matching uuid`
+const NoMarketFound = `No market with matching name`
+const NoSecurityFound = `No security with matching isin`
+const NoUserFound = `No user with matching uuid`
+const UsernameTaken = `Username is already taken`
+const LoginLocked = `Too many failed logins, try again later`
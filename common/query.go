@@ -0,0 +1,411 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldType is the type the values of a filter are parsed as
+type FieldType int
+
+const (
+	TextField FieldType = iota
+	NumberField
+	IntegerField
+	TimeField
+)
+
+// FilterOperator matches the operators of the FieldFilter messages of the services
+type FilterOperator int32
+
+const (
+	OperatorEq FilterOperator = iota
+	OperatorNeq
+	OperatorGt
+	OperatorGte
+	OperatorLt
+	OperatorLte
+	OperatorIn
+)
+
+// QueryField is a field clients are allowed to sort and filter a listing by
+type QueryField struct {
+	Column string
+	Type   FieldType
+}
+
+// QueryFields is the whitelist of fields of a listing, keyed by the name used by clients
+type QueryFields map[string]QueryField
+
+type SortKey struct {
+	Field     string
+	Ascending bool
+}
+
+type FieldFilter struct {
+	Field    string
+	Operator FilterOperator
+	Values   []string
+}
+
+// PagedSpec is a paged listing request as sent by clients
+type PagedSpec struct {
+	PageSize   uint64
+	PageNumber uint64
+	PageToken  string
+	Text       string
+	Sort       []SortKey
+	Filters    []FieldFilter
+}
+
+// PagedQuery is a validated paged listing query, which only references whitelisted columns.
+// Listings are always ordered by id last, so a page can be continued from its last row.
+type PagedQuery struct {
+	PageSize   uint64
+	PageNumber uint64
+	Text       string
+
+	sort       []sortColumn
+	orderBy    []string
+	conditions []squirrel.Sqlizer
+	keyset     squirrel.Sqlizer
+	// filter is the hash of the filters and text the query matches rows by
+	filter string
+}
+
+type sortColumn struct {
+	field     QueryField
+	ascending bool
+}
+
+// pageToken holds the sort values of the last row of a page. Sort and Filter are the
+// ordering and the hash of the filters the token was issued for, so it can't be used
+// with other ones.
+type pageToken struct {
+	Sort   string          `json:"s"`
+	Filter string          `json:"f"`
+	Cursor json.RawMessage `json:"c"`
+}
+
+// PagedSpecFromProto reads the PagedFilter message of any of the services, which all share its fields
+func PagedSpecFromProto(filter proto.Message) PagedSpec {
+	m := filter.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	spec := PagedSpec{
+		PageSize:   m.Get(fields.ByName("pageSize")).Uint(),
+		PageNumber: m.Get(fields.ByName("pageNumber")).Uint(),
+		PageToken:  m.Get(fields.ByName("pageToken")).String(),
+		Text:       m.Get(fields.ByName("text")).String(),
+	}
+
+	sort := m.Get(fields.ByName("sort")).List()
+	for i := 0; i < sort.Len(); i++ {
+		key := sort.Get(i).Message()
+		keyFields := key.Descriptor().Fields()
+		spec.Sort = append(spec.Sort, SortKey{
+			Field:     key.Get(keyFields.ByName("field")).String(),
+			Ascending: key.Get(keyFields.ByName("ascending")).Bool(),
+		})
+	}
+	if len(spec.Sort) == 0 {
+		if order := m.Get(fields.ByName("order")).String(); order != "" {
+			spec.Sort = append(spec.Sort, SortKey{Field: order, Ascending: m.Get(fields.ByName("ascending")).Bool()})
+		}
+	}
+
+	filters := m.Get(fields.ByName("filters")).List()
+	for i := 0; i < filters.Len(); i++ {
+		spec.Filters = append(spec.Filters, fieldFilterFromReflect(filters.Get(i).Message()))
+	}
+
+	return spec
+}
+
+// FieldFilterFromProto reads the FieldFilter message of any of the services
+func FieldFilterFromProto(filter proto.Message) FieldFilter {
+	return fieldFilterFromReflect(filter.ProtoReflect())
+}
+
+func fieldFilterFromReflect(m protoreflect.Message) FieldFilter {
+	fields := m.Descriptor().Fields()
+	res := FieldFilter{
+		Field:    m.Get(fields.ByName("field")).String(),
+		Operator: FilterOperator(m.Get(fields.ByName("operator")).Enum()),
+	}
+	values := m.Get(fields.ByName("values")).List()
+	for i := 0; i < values.Len(); i++ {
+		res.Values = append(res.Values, values.Get(i).String())
+	}
+
+	return res
+}
+
+// Compile validates the sort keys and filters against the whitelist and builds a query
+// from them. Filter values are parsed according to the field type and passed as arguments.
+func (f QueryFields) Compile(spec PagedSpec) (*PagedQuery, error) {
+	if spec.PageSize == 0 {
+		return nil, status.Error(codes.InvalidArgument, "provide page size")
+	}
+
+	q := &PagedQuery{
+		PageSize:   spec.PageSize,
+		PageNumber: spec.PageNumber,
+		Text:       spec.Text,
+	}
+	if q.PageNumber == 0 {
+		q.PageNumber = 1
+	}
+
+	for _, key := range spec.Sort {
+		field, ok := f[key.Field]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "can't sort by %s", key.Field)
+		}
+		q.sort = append(q.sort, sortColumn{field: field, ascending: key.Ascending})
+		if key.Ascending {
+			q.orderBy = append(q.orderBy, field.Column+" asc")
+		} else {
+			q.orderBy = append(q.orderBy, field.Column+" desc")
+		}
+	}
+	q.orderBy = append(q.orderBy, "id asc")
+
+	conditions, err := f.Conditions(spec.Filters)
+	if err != nil {
+		return nil, err
+	}
+	q.conditions = conditions
+	q.filter, err = filterHash(conditions, spec.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.PageToken != "" {
+		keyset, err := q.keysetCondition(spec.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		q.keyset = keyset
+	}
+
+	return q, nil
+}
+
+// Conditions validates the filters against the whitelist and builds their conditions
+func (f QueryFields) Conditions(filters []FieldFilter) (squirrel.And, error) {
+	conditions := squirrel.And{}
+	for _, filter := range filters {
+		field, ok := f[filter.Field]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "can't filter by %s", filter.Field)
+		}
+
+		cond, err := field.condition(filter)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, cond)
+	}
+
+	return conditions, nil
+}
+
+// keysetCondition decodes the page token and builds the condition selecting the rows after it
+func (q *PagedQuery) keysetCondition(token string) (squirrel.Sqlizer, error) {
+	invalid := status.Error(codes.InvalidArgument, "invalid page token")
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	var t pageToken
+	if err = json.Unmarshal(raw, &t); err != nil {
+		return nil, invalid
+	}
+	if t.Sort != strings.Join(q.orderBy, ",") {
+		return nil, status.Error(codes.InvalidArgument, "page token was issued for a different order")
+	}
+	if t.Filter != q.filter {
+		return nil, status.Error(codes.InvalidArgument, "page token was issued for different filters")
+	}
+
+	var cursor []interface{}
+	if err = json.Unmarshal(t.Cursor, &cursor); err != nil || len(cursor) != len(q.sort)+1 {
+		return nil, invalid
+	}
+
+	// the id is the last sort column
+	columns := append(append([]sortColumn{}, q.sort...), sortColumn{field: QueryField{Column: "id", Type: IntegerField}, ascending: true})
+	values := make([]interface{}, len(columns))
+	for i, c := range columns {
+		values[i], err = c.field.cursorValue(cursor[i])
+		if err != nil {
+			return nil, invalid
+		}
+	}
+
+	// (a > x) OR (a = x AND b > y) OR ...
+	var or squirrel.Or
+	for i, c := range columns {
+		and := squirrel.And{}
+		for j := 0; j < i; j++ {
+			and = append(and, squirrel.Eq{columns[j].field.Column: values[j]})
+		}
+		if c.ascending {
+			and = append(and, squirrel.Gt{c.field.Column: values[i]})
+		} else {
+			and = append(and, squirrel.Lt{c.field.Column: values[i]})
+		}
+		or = append(or, and)
+	}
+
+	return or, nil
+}
+
+// filterHash hashes the conditions built from the filters, with their parsed values, and the text
+func filterHash(conditions squirrel.And, text string) (string, error) {
+	sql, args, err := conditions.ToSql()
+	if err != nil {
+		return "", err
+	}
+	raw, err := json.Marshal([]interface{}{sql, args, text})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}
+
+// cursorValue converts a value of the cursor, as encoded by json_build_array, to the field type
+func (f QueryField) cursorValue(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case float64:
+		if f.Type == IntegerField && value == math.Trunc(value) {
+			return int64(value), nil
+		}
+		if f.Type != NumberField {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor value")
+		}
+		return value, nil
+	case string:
+		if f.Type == NumberField || f.Type == IntegerField {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor value")
+		}
+		return f.parse(value)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor value")
+	}
+}
+
+func (f QueryField) condition(filter FieldFilter) (squirrel.Sqlizer, error) {
+	if len(filter.Values) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "provide a value to filter %s by", filter.Field)
+	}
+	if filter.Operator != OperatorIn && len(filter.Values) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "only the in operator accepts multiple values for %s", filter.Field)
+	}
+
+	var values []interface{}
+	for _, v := range filter.Values {
+		parsed, err := f.parse(v)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid value %q for %s", v, filter.Field)
+		}
+		values = append(values, parsed)
+	}
+
+	switch filter.Operator {
+	case OperatorEq:
+		return squirrel.Eq{f.Column: values[0]}, nil
+	case OperatorNeq:
+		return squirrel.NotEq{f.Column: values[0]}, nil
+	case OperatorGt:
+		return squirrel.Gt{f.Column: values[0]}, nil
+	case OperatorGte:
+		return squirrel.GtOrEq{f.Column: values[0]}, nil
+	case OperatorLt:
+		return squirrel.Lt{f.Column: values[0]}, nil
+	case OperatorLte:
+		return squirrel.LtOrEq{f.Column: values[0]}, nil
+	case OperatorIn:
+		return squirrel.Eq{f.Column: values}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown operator for %s", filter.Field)
+	}
+}
+
+func (f QueryField) parse(value string) (interface{}, error) {
+	switch f.Type {
+	case NumberField:
+		return strconv.ParseFloat(value, 64)
+	case IntegerField:
+		return strconv.ParseInt(value, 10, 64)
+	case TimeField:
+		return time.Parse(time.RFC3339, value)
+	default:
+		return value, nil
+	}
+}
+
+// Apply adds the filters, ordering and paging of the query to the builder, along with the
+// total_count column and a cursor column holding the sort values of each row, which is
+// passed to NextPageToken
+func (q *PagedQuery) Apply(b squirrel.SelectBuilder) squirrel.SelectBuilder {
+	for _, cond := range q.conditions {
+		b = b.Where(cond)
+	}
+
+	// counting the matches of every page would scan the rest of the listing each time,
+	// so they are only counted when a page is requested by number
+	if q.keyset != nil {
+		b = b.Column("0::bigint AS total_count")
+	} else {
+		b = b.Column("count(*) OVER() AS total_count")
+	}
+
+	var cursor []string
+	for _, c := range q.sort {
+		cursor = append(cursor, c.field.Column)
+	}
+	cursor = append(cursor, "id")
+	b = b.Column("json_build_array(" + strings.Join(cursor, ", ") + ")::text AS cursor")
+
+	if q.keyset != nil {
+		b = b.Where(q.keyset)
+	} else {
+		b = b.Offset((q.PageNumber - 1) * q.PageSize)
+	}
+
+	return b.
+		OrderBy(q.orderBy...).
+		Limit(q.PageSize)
+}
+
+// NextPageToken returns the token of the page after the one read, given the number of rows
+// read and the cursor of the last one. It is empty if the page wasn't full.
+func (q *PagedQuery) NextPageToken(rows int, lastCursor string) string {
+	if uint64(rows) < q.PageSize || lastCursor == "" {
+		return ""
+	}
+
+	raw, _ := json.Marshal(pageToken{
+		Sort:   strings.Join(q.orderBy, ","),
+		Filter: q.filter,
+		Cursor: json.RawMessage(lastCursor),
+	})
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
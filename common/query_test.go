@@ -0,0 +1,194 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testQueryFields = QueryFields{
+	"name":      {Column: "name", Type: TextField},
+	"price":     {Column: "price", Type: NumberField},
+	"count":     {Column: "count", Type: IntegerField},
+	"createdAt": {Column: "created_at", Type: TimeField},
+}
+
+func applyTestQuery(q *PagedQuery) (string, []interface{}, error) {
+	return q.Apply(squirrel.Select("id").From("t").PlaceholderFormat(squirrel.Dollar)).ToSql()
+}
+
+func TestCompile(t *testing.T) {
+	createdAt := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		spec PagedSpec
+		sql  string
+		args []interface{}
+	}{
+		{
+			"first page",
+			PagedSpec{PageSize: 10},
+			"SELECT id, count(*) OVER() AS total_count, json_build_array(id)::text AS cursor FROM t " +
+				"ORDER BY id asc LIMIT 10 OFFSET 0",
+			nil,
+		},
+		{
+			"page by number",
+			PagedSpec{PageSize: 10, PageNumber: 3, Sort: []SortKey{{Field: "price"}, {Field: "name", Ascending: true}}},
+			"SELECT id, count(*) OVER() AS total_count, json_build_array(price, name, id)::text AS cursor FROM t " +
+				"ORDER BY price desc, name asc, id asc LIMIT 10 OFFSET 20",
+			nil,
+		},
+		{
+			"filters",
+			PagedSpec{PageSize: 10, Filters: []FieldFilter{
+				{Field: "name", Operator: OperatorEq, Values: []string{"a"}},
+				{Field: "name", Operator: OperatorNeq, Values: []string{"b"}},
+				{Field: "price", Operator: OperatorGt, Values: []string{"1.5"}},
+				{Field: "price", Operator: OperatorGte, Values: []string{"2"}},
+				{Field: "count", Operator: OperatorLt, Values: []string{"3"}},
+				{Field: "createdAt", Operator: OperatorLte, Values: []string{"2021-03-01T12:00:00Z"}},
+				{Field: "count", Operator: OperatorIn, Values: []string{"4", "5"}},
+			}},
+			"SELECT id, count(*) OVER() AS total_count, json_build_array(id)::text AS cursor FROM t " +
+				"WHERE name = $1 AND name <> $2 AND price > $3 AND price >= $4 AND count < $5 AND created_at <= $6 AND count IN ($7,$8) " +
+				"ORDER BY id asc LIMIT 10 OFFSET 0",
+			[]interface{}{"a", "b", 1.5, 2.0, int64(3), createdAt, int64(4), int64(5)},
+		},
+	}
+
+	for _, test := range tests {
+		q, err := testQueryFields.Compile(test.spec)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		sql, args, err := applyTestQuery(q)
+		if err != nil || sql != test.sql || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %s %v %v, want %s %v", test.name, sql, args, err, test.sql, test.args)
+		}
+	}
+}
+
+func TestCompileRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		spec PagedSpec
+	}{
+		{"no page size", PagedSpec{}},
+		{"unknown sort field", PagedSpec{PageSize: 10, Sort: []SortKey{{Field: "id; drop table t"}}}},
+		{"unknown filter field", PagedSpec{PageSize: 10, Filters: []FieldFilter{{Field: "password", Values: []string{"a"}}}}},
+		{"no filter value", PagedSpec{PageSize: 10, Filters: []FieldFilter{{Field: "name"}}}},
+		{"multiple values", PagedSpec{PageSize: 10, Filters: []FieldFilter{{Field: "name", Values: []string{"a", "b"}}}}},
+		{"invalid number", PagedSpec{PageSize: 10, Filters: []FieldFilter{{Field: "price", Values: []string{"a"}}}}},
+		{"invalid integer", PagedSpec{PageSize: 10, Filters: []FieldFilter{{Field: "count", Values: []string{"1.5"}}}}},
+		{"invalid time", PagedSpec{PageSize: 10, Filters: []FieldFilter{{Field: "createdAt", Values: []string{"2021-03-01"}}}}},
+		{"unknown operator", PagedSpec{PageSize: 10, Filters: []FieldFilter{{Field: "name", Operator: 42, Values: []string{"a"}}}}},
+	}
+
+	for _, test := range tests {
+		if _, err := testQueryFields.Compile(test.spec); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got %v, want InvalidArgument", test.name, err)
+		}
+	}
+}
+
+func TestNextPageToken(t *testing.T) {
+	q, err := testQueryFields.Compile(PagedSpec{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := q.NextPageToken(1, "[1]"); token != "" {
+		t.Errorf("token %q of a partial page, want none", token)
+	}
+	if token := q.NextPageToken(2, ""); token != "" {
+		t.Errorf("token %q without a cursor, want none", token)
+	}
+	if token := q.NextPageToken(2, "[2]"); token == "" {
+		t.Error("no token of a full page")
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	spec := PagedSpec{
+		PageSize: 10,
+		Text:     "apple",
+		Sort:     []SortKey{{Field: "price"}, {Field: "createdAt", Ascending: true}},
+		Filters:  []FieldFilter{{Field: "name", Operator: OperatorIn, Values: []string{"a", "b"}}},
+	}
+	first, err := testQueryFields.Compile(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := first.NextPageToken(10, `[1.5, "2021-03-01T12:00:00Z", 7]`)
+
+	next := spec
+	next.PageToken = token
+	q, err := testQueryFields.Compile(next)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql, args, err := applyTestQuery(q)
+	wantSql := "SELECT id, 0::bigint AS total_count, json_build_array(price, created_at, id)::text AS cursor FROM t " +
+		"WHERE name IN ($1,$2) AND ((price < $3) OR (price = $4 AND created_at > $5) OR (price = $6 AND created_at = $7 AND id > $8)) " +
+		"ORDER BY price desc, created_at asc, id asc LIMIT 10"
+	createdAt := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	wantArgs := []interface{}{"a", "b", 1.5, 1.5, createdAt, 1.5, createdAt, int64(7)}
+	if err != nil || sql != wantSql || !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("got %s %v %v, want %s %v", sql, args, err, wantSql, wantArgs)
+	}
+
+	encode := func(t pageToken) string {
+		raw, _ := json.Marshal(t)
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+	var issued pageToken
+	raw, _ := base64.RawURLEncoding.DecodeString(token)
+	if err = json.Unmarshal(raw, &issued); err != nil {
+		t.Fatal(err)
+	}
+	withCursor := func(cursor string) string {
+		t := issued
+		t.Cursor = json.RawMessage(cursor)
+		return encode(t)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(spec *PagedSpec)
+	}{
+		{"not base64", func(spec *PagedSpec) { spec.PageToken = "!" }},
+		{"not json", func(spec *PagedSpec) { spec.PageToken = base64.RawURLEncoding.EncodeToString([]byte("{")) }},
+		{"other sort field", func(spec *PagedSpec) { spec.Sort = []SortKey{{Field: "count"}, {Field: "createdAt", Ascending: true}} }},
+		{"other sort direction", func(spec *PagedSpec) { spec.Sort[0].Ascending = true }},
+		{"other filter value", func(spec *PagedSpec) {
+			spec.Filters = []FieldFilter{{Field: "name", Operator: OperatorIn, Values: []string{"a"}}}
+		}},
+		{"other filter operator", func(spec *PagedSpec) {
+			spec.Filters = []FieldFilter{{Field: "name", Operator: OperatorEq, Values: []string{"a"}}}
+		}},
+		{"no filters", func(spec *PagedSpec) { spec.Filters = nil }},
+		{"other text", func(spec *PagedSpec) { spec.Text = "pear" }},
+		{"short cursor", func(spec *PagedSpec) { spec.PageToken = withCursor(`[1.5, 7]`) }},
+		{"text in number", func(spec *PagedSpec) { spec.PageToken = withCursor(`["1.5", "2021-03-01T12:00:00Z", 7]`) }},
+		{"invalid time", func(spec *PagedSpec) { spec.PageToken = withCursor(`[1.5, "yesterday", 7]`) }},
+		{"fractional id", func(spec *PagedSpec) { spec.PageToken = withCursor(`[1.5, "2021-03-01T12:00:00Z", 7.5]`) }},
+		{"object in cursor", func(spec *PagedSpec) { spec.PageToken = withCursor(`[{}, "2021-03-01T12:00:00Z", 7]`) }},
+	}
+
+	for _, test := range tests {
+		modified := next
+		modified.Sort = append([]SortKey{}, next.Sort...)
+		test.modify(&modified)
+		if _, err := testQueryFields.Compile(modified); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got %v, want InvalidArgument", test.name, err)
+		}
+	}
+}
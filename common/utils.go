@@ -2,8 +2,9 @@ package common
 
 import (
 	"fmt"
-	"math/rand"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
 
 	"github.com/jackc/pgx"
@@ -12,17 +13,6 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func FormatOrderQuery(attr string, asc bool) string {
-	var d string
-	if asc {
-		d = "asc"
-	} else {
-		d = "desc"
-	}
-
-	return fmt.Sprintf("%s %s", attr, d)
-}
-
 func ContainsSymbol(uuid string, arr []*instrument_service.Instrument) (bool, *instrument_service.Instrument) {
 	for _, v := range arr {
 		if v.Uuid == uuid {
@@ -32,6 +22,17 @@ func ContainsSymbol(uuid string, arr []*instrument_service.Instrument) (bool, *i
 	return false, nil
 }
 
+// InstrumentUuid generates the reproducible uuid of an instrument
+func InstrumentUuid(isin, identifier, marketName string) (string, error) {
+	ns, err := uuid.FromString(SymbolsNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	str := fmt.Sprintf("%s,%s,%s", isin, identifier, marketName)
+	return uuid.NewV5(ns, str).String(), nil
+}
+
 func GetErrorStatus(err error) error {
 	if err != nil {
 		st, ok := status.FromError(err)
@@ -54,24 +55,6 @@ func GetErrorStatus(err error) error {
 	return err
 }
 
-func RandomStringWithLength(n int) string {
-	b := make([]byte, n)
-	// A rand.Int63() generates 63 random bits, enough for letterIdxMax letters!
-	for i, cache, remain := n-1, rand.Int63(), letterIdxMax; i >= 0; {
-		if remain == 0 {
-			cache, remain = rand.Int63(), letterIdxMax
-		}
-		if idx := int(cache & letterIdxMask); idx < len(letterBytes) {
-			b[i] = letterBytes[idx]
-			i--
-		}
-		cache >>= letterIdxBits
-		remain--
-	}
-
-	return string(b)
-}
-
 func RollingAverage(n int) func(float64) float64 {
 	bins := make([]float64, n)
 	avg := 0.0
@@ -83,3 +66,26 @@ func RollingAverage(n int) func(float64) float64 {
 		return avg
 	}
 }
+
+// ChartRangeStart returns the start of the chart range ending at the given time, or false if the range is unknown.
+// The year to date starts on the first of January in the location of the end, which should be the user's.
+func ChartRangeStart(chartRange string, end time.Time) (time.Time, bool) {
+	switch chartRange {
+	case ChartRange1M:
+		return end.AddDate(0, -1, 0), true
+	case ChartRange3M:
+		return end.AddDate(0, -3, 0), true
+	case ChartRange6M:
+		return end.AddDate(0, -6, 0), true
+	case ChartRangeYTD:
+		return time.Date(end.Year(), 1, 1, 0, 0, 0, 0, end.Location()), true
+	case ChartRange1Y:
+		return end.AddDate(-1, 0, 0), true
+	case ChartRange5Y:
+		return end.AddDate(-5, 0, 0), true
+	case ChartRangeMax:
+		return time.Time{}, true
+	}
+
+	return time.Time{}, false
+}
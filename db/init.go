@@ -48,6 +48,133 @@ func CreateMongoIndexes(db *mongo.Database) error {
 		Collection(common.HistoriesCollection).
 		Indexes().
 		CreateOne(context.Background(), historiesSymbolUuidTimestamp)
+	if err != nil {
+		return err
+	}
+
+	// overviews are upserted by ISIN, so replicas refreshing the same security can't insert it twice.
+	// Overviews of symbols without an ISIN are keyed by their uuid.
+	_, err = db.
+		Collection(common.OverviewsCollection).
+		Indexes().
+		CreateMany(context.Background(), []mongo.IndexModel{
+			{
+				Keys: bson.D{{Key: "isin", Value: 1}},
+				Options: options.Index().
+					SetUnique(true).
+					SetPartialFilterExpression(bson.M{"isin": bson.M{"$type": "string", "$gt": ""}}),
+			},
+			{Keys: bson.D{{Key: "symboluuid", Value: 1}}},
+			{Keys: bson.D{{Key: "industry", Value: 1}, {Key: "marketcapitalization", Value: -1}}},
+		})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.
+		Collection(common.OverviewSnapshotsCollection).
+		Indexes().
+		CreateMany(context.Background(), []mongo.IndexModel{
+			{Keys: bson.D{{Key: "isin", Value: 1}, {Key: "updatedat", Value: 1}}},
+			{Keys: bson.D{{Key: "symboluuid", Value: 1}, {Key: "updatedat", Value: 1}}},
+		})
+	if err != nil {
+		return err
+	}
+
+	_, err = db.
+		Collection(common.FinancialReportsCollection).
+		Indexes().
+		CreateMany(context.Background(), []mongo.IndexModel{
+			{Keys: bson.D{{Key: "isin", Value: 1}, {Key: "statement", Value: 1}, {Key: "period", Value: 1}, {Key: "fiscaldateending", Value: 1}}},
+			{Keys: bson.D{{Key: "symboluuid", Value: 1}, {Key: "statement", Value: 1}, {Key: "period", Value: 1}, {Key: "fiscaldateending", Value: 1}}},
+			{Keys: bson.D{{Key: "isin", Value: 1}, {Key: "fetchedat", Value: -1}}},
+			{Keys: bson.D{{Key: "symboluuid", Value: 1}, {Key: "fetchedat", Value: -1}}},
+		})
 
 	return err
 }
+
+// mongoMigration is a change of the stored documents, which is applied once
+type mongoMigration struct {
+	name  string
+	apply func(ctx context.Context, db *mongo.Database) error
+}
+
+var mongoMigrations = []mongoMigration{
+	{
+		// replicas could insert an overview of the same ISIN twice before overviews were unique
+		// by ISIN, of which the last updated one is kept
+		name: "dedupe-overviews-by-isin",
+		apply: func(ctx context.Context, db *mongo.Database) error {
+			collection := db.Collection(common.OverviewsCollection)
+			cur, err := collection.Aggregate(ctx, []bson.M{
+				{"$match": bson.M{"isin": bson.M{"$type": "string", "$gt": ""}}},
+				{"$sort": bson.D{{Key: "updatedat", Value: -1}, {Key: "_id", Value: -1}}},
+				{"$group": bson.M{"_id": "$isin", "ids": bson.M{"$push": "$_id"}}},
+				{"$match": bson.M{"ids.1": bson.M{"$exists": true}}},
+			})
+			if err != nil {
+				return err
+			}
+
+			var duplicates []struct {
+				Ids []interface{} `bson:"ids"`
+			}
+			if err = cur.All(ctx, &duplicates); err != nil {
+				return err
+			}
+			for _, d := range duplicates {
+				if _, err = collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": d.Ids[1:]}}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		// overviews were stored with the book value and dividends parsed from the P/E ratio
+		name: "clear-misparsed-overview-values",
+		apply: func(ctx context.Context, db *mongo.Database) error {
+			filter := bson.M{"$expr": bson.M{"$and": []bson.M{
+				{"$eq": []string{"$bookvalue", "$peratio"}},
+				{"$eq": []string{"$dividendpershare", "$peratio"}},
+				{"$eq": []string{"$dividendyield", "$peratio"}},
+			}}}
+			update := bson.M{"$set": bson.M{"bookvalue": nil, "dividendpershare": nil, "dividendyield": nil}}
+			for _, collection := range []string{common.OverviewsCollection, common.OverviewSnapshotsCollection} {
+				if _, err := db.Collection(collection).UpdateMany(ctx, filter, update); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+}
+
+// MigrateMongo applies the migrations which weren't applied yet, recording each once it succeeded
+func MigrateMongo(db *mongo.Database) error {
+	ctx := context.Background()
+	for _, m := range mongoMigrations {
+		err := db.Collection(common.MigrationsCollection).
+			FindOne(ctx, bson.M{"_id": m.name}).
+			Err()
+		if err == nil {
+			continue
+		}
+		if err != mongo.ErrNoDocuments {
+			return err
+		}
+
+		if err = m.apply(ctx, db); err != nil {
+			return err
+		}
+		_, err = db.Collection(common.MigrationsCollection).
+			InsertOne(ctx, bson.M{"_id": m.name, "appliedat": time.Now()})
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"math"
 	"strconv"
 	"time"
 )
@@ -67,103 +68,65 @@ type InstrumentOverviewResponse struct {
 	LastSplitDate              string `json:"LastSplitDate"`
 }
 
-func (s *InstrumentOverviewResponse) ToEntity(uuid string) *InstrumentOverview {
-	fullTimeEmployees, _ := strconv.ParseInt(s.FullTimeEmployees, 10, 64)
+// ToEntity parses the overview. Every value is parsed from its own field, and values which
+// are reported as None or can't be parsed are left nil.
+func (s *InstrumentOverviewResponse) ToEntity(uuid string, isin string) *InstrumentOverview {
 	latestQuarter, _ := time.Parse("2006-01-02", s.LatestQuarter)
-	marketCapitalization, _ := strconv.ParseInt(s.MarketCapitalization, 10, 64)
-	ebitda, _ := strconv.ParseInt(s.EBITDA, 10, 64)
-	peRatio, _ := strconv.ParseFloat(s.PERatio, 32)
-	pegRatio, _ := strconv.ParseFloat(s.PEGRatio, 32)
-	bookValue, _ := strconv.ParseFloat(s.PERatio, 32)
-	dividendPerShare, _ := strconv.ParseFloat(s.PERatio, 32)
-	dividendYield, _ := strconv.ParseFloat(s.PERatio, 32)
-	revenuePerShareTTM, _ := strconv.ParseFloat(s.RevenuePerShareTTM, 32)
-	profitMargin, _ := strconv.ParseFloat(s.ProfitMargin, 32)
-	operatingMarginTTM, _ := strconv.ParseFloat(s.OperatingMarginTTM, 32)
-	returnOnAssetsTTM, _ := strconv.ParseFloat(s.ReturnOnAssetsTTM, 32)
-	eps, _ := strconv.ParseFloat(s.EPS, 32)
-	returnOnEquityTTM, _ := strconv.ParseFloat(s.ReturnOnEquityTTM, 32)
-	revenueTTM, _ := strconv.ParseInt(s.RevenueTTM, 10, 64)
-	grossProfitTTM, _ := strconv.ParseInt(s.GrossProfitTTM, 10, 64)
-	dilutedEPSTTM, _ := strconv.ParseFloat(s.DilutedEPSTTM, 32)
-	quarterlyEarningsGrowthYOY, _ := strconv.ParseFloat(s.QuarterlyEarningsGrowthYOY, 32)
-	quarterlyRevenueGrowthYOY, _ := strconv.ParseFloat(s.QuarterlyRevenueGrowthYOY, 32)
-	analystTargetPrice, _ := strconv.ParseFloat(s.AnalystTargetPrice, 32)
-	trailingPE, _ := strconv.ParseFloat(s.TrailingPE, 32)
-	forwardPE, _ := strconv.ParseFloat(s.ForwardPE, 32)
-	priceToSalesRatioTTM, _ := strconv.ParseFloat(s.PriceToSalesRatioTTM, 32)
-	priceToBookRatio, _ := strconv.ParseFloat(s.PriceToBookRatio, 32)
-	eVToRevenue, _ := strconv.ParseFloat(s.EVToRevenue, 32)
-	eVToEBITDA, _ := strconv.ParseFloat(s.EVToEBITDA, 32)
-	beta, _ := strconv.ParseFloat(s.Beta, 32)
-	weekHigh52, _ := strconv.ParseFloat(s.WeekHigh52, 32)
-	weekLow52, _ := strconv.ParseFloat(s.WeekLow52, 32)
-	sharesOutstanding, _ := strconv.ParseInt(s.SharesOutstanding, 10, 32)
-	sharesFloat, _ := strconv.ParseInt(s.SharesFloat, 10, 32)
-	sharesShort, _ := strconv.ParseInt(s.SharesShort, 10, 32)
-	sharesShortPriorMonth, _ := strconv.ParseInt(s.SharesShortPriorMonth, 10, 32)
-	shortRatio, _ := strconv.ParseFloat(s.ShortRatio, 32)
-	shortPercentOutstanding, _ := strconv.ParseFloat(s.ShortPercentOutstanding, 32)
-	shortPercentFloat, _ := strconv.ParseFloat(s.ShortPercentFloat, 32)
-	percentInsiders, _ := strconv.ParseFloat(s.PercentInsiders, 32)
-	percentInstitutions, _ := strconv.ParseFloat(s.PercentInstitutions, 32)
-	forwardAnnualDividendRate, _ := strconv.ParseFloat(s.ForwardAnnualDividendRate, 32)
-	forwardAnnualDividendYield, _ := strconv.ParseFloat(s.ForwardAnnualDividendYield, 32)
-	payoutRatio, _ := strconv.ParseFloat(s.PayoutRatio, 32)
 	dividendDate, _ := time.Parse("2006-01-02", s.DividendDate)
 	exDividendDate, _ := time.Parse("2006-01-02", s.ExDividendDate)
 	lastSplitDate, _ := time.Parse("2006-01-02", s.LastSplitDate)
 
 	return &InstrumentOverview{
 		SymbolUuid:                 uuid,
+		Isin:                       isin,
 		Description:                s.Description,
 		Country:                    s.Country,
 		Sector:                     s.Sector,
 		Industry:                   s.Industry,
 		Address:                    s.Address,
-		FullTimeEmployees:          fullTimeEmployees,
+		FullTimeEmployees:          parseInt(s.FullTimeEmployees),
 		FiscalYearEnd:              s.FiscalYearEnd,
 		LatestQuarter:              latestQuarter,
-		MarketCapitalization:       marketCapitalization,
-		EBITDA:                     ebitda,
-		PERatio:                    float32(peRatio),
-		PEGRatio:                   float32(pegRatio),
-		BookValue:                  float32(bookValue),
-		DividendPerShare:           float32(dividendPerShare),
-		DividendYield:              float32(dividendYield),
-		EPS:                        float32(eps),
-		RevenuePerShareTTM:         float32(revenuePerShareTTM),
-		ProfitMargin:               float32(profitMargin),
-		OperatingMarginTTM:         float32(operatingMarginTTM),
-		ReturnOnAssetsTTM:          float32(returnOnAssetsTTM),
-		ReturnOnEquityTTM:          float32(returnOnEquityTTM),
-		RevenueTTM:                 revenueTTM,
-		GrossProfitTTM:             grossProfitTTM,
-		DilutedEPSTTM:              float32(dilutedEPSTTM),
-		QuarterlyEarningsGrowthYOY: float32(quarterlyEarningsGrowthYOY),
-		QuarterlyRevenueGrowthYOY:  float32(quarterlyRevenueGrowthYOY),
-		AnalystTargetPrice:         float32(analystTargetPrice),
-		TrailingPE:                 float32(trailingPE),
-		ForwardPE:                  float32(forwardPE),
-		PriceToSalesRatioTTM:       float32(priceToSalesRatioTTM),
-		PriceToBookRatio:           float32(priceToBookRatio),
-		EVToRevenue:                float32(eVToRevenue),
-		EVToEBITDA:                 float32(eVToEBITDA),
-		Beta:                       float32(beta),
-		WeekHigh52:                 float32(weekHigh52),
-		WeekLow52:                  float32(weekLow52),
-		SharesOutstanding:          sharesOutstanding,
-		SharesFloat:                sharesFloat,
-		SharesShort:                sharesShort,
-		SharesShortPriorMonth:      sharesShortPriorMonth,
-		ShortRatio:                 float32(shortRatio),
-		ShortPercentOutstanding:    float32(shortPercentOutstanding),
-		ShortPercentFloat:          float32(shortPercentFloat),
-		PercentInsiders:            float32(percentInsiders),
-		PercentInstitutions:        float32(percentInstitutions),
-		ForwardAnnualDividendRate:  float32(forwardAnnualDividendRate),
-		ForwardAnnualDividendYield: float32(forwardAnnualDividendYield),
-		PayoutRatio:                float32(payoutRatio),
+		MarketCapitalization:       parseInt(s.MarketCapitalization),
+		EBITDA:                     parseInt(s.EBITDA),
+		PERatio:                    parseFloat(s.PERatio),
+		PEGRatio:                   parseFloat(s.PEGRatio),
+		BookValue:                  parseFloat(s.BookValue),
+		DividendPerShare:           parseFloat(s.DividendPerShare),
+		DividendYield:              parseFloat(s.DividendYield),
+		EPS:                        parseFloat(s.EPS),
+		RevenuePerShareTTM:         parseFloat(s.RevenuePerShareTTM),
+		ProfitMargin:               parseFloat(s.ProfitMargin),
+		OperatingMarginTTM:         parseFloat(s.OperatingMarginTTM),
+		ReturnOnAssetsTTM:          parseFloat(s.ReturnOnAssetsTTM),
+		ReturnOnEquityTTM:          parseFloat(s.ReturnOnEquityTTM),
+		RevenueTTM:                 parseInt(s.RevenueTTM),
+		GrossProfitTTM:             parseInt(s.GrossProfitTTM),
+		DilutedEPSTTM:              parseFloat(s.DilutedEPSTTM),
+		QuarterlyEarningsGrowthYOY: parseFloat(s.QuarterlyEarningsGrowthYOY),
+		QuarterlyRevenueGrowthYOY:  parseFloat(s.QuarterlyRevenueGrowthYOY),
+		AnalystTargetPrice:         parseFloat(s.AnalystTargetPrice),
+		TrailingPE:                 parseFloat(s.TrailingPE),
+		ForwardPE:                  parseFloat(s.ForwardPE),
+		PriceToSalesRatioTTM:       parseFloat(s.PriceToSalesRatioTTM),
+		PriceToBookRatio:           parseFloat(s.PriceToBookRatio),
+		EVToRevenue:                parseFloat(s.EVToRevenue),
+		EVToEBITDA:                 parseFloat(s.EVToEBITDA),
+		Beta:                       parseFloat(s.Beta),
+		WeekHigh52:                 parseFloat(s.WeekHigh52),
+		WeekLow52:                  parseFloat(s.WeekLow52),
+		SharesOutstanding:          parseInt(s.SharesOutstanding),
+		SharesFloat:                parseInt(s.SharesFloat),
+		SharesShort:                parseInt(s.SharesShort),
+		SharesShortPriorMonth:      parseInt(s.SharesShortPriorMonth),
+		ShortRatio:                 parseFloat(s.ShortRatio),
+		ShortPercentOutstanding:    parseFloat(s.ShortPercentOutstanding),
+		ShortPercentFloat:          parseFloat(s.ShortPercentFloat),
+		PercentInsiders:            parseFloat(s.PercentInsiders),
+		PercentInstitutions:        parseFloat(s.PercentInstitutions),
+		ForwardAnnualDividendRate:  parseFloat(s.ForwardAnnualDividendRate),
+		ForwardAnnualDividendYield: parseFloat(s.ForwardAnnualDividendYield),
+		PayoutRatio:                parseFloat(s.PayoutRatio),
 		DividendDate:               dividendDate,
 		ExDividendDate:             exDividendDate,
 		LastSplitFactor:            s.LastSplitFactor,
@@ -171,3 +134,24 @@ func (s *InstrumentOverviewResponse) ToEntity(uuid string) *InstrumentOverview {
 		UpdatedAt:                  time.Now(),
 	}
 }
+
+// parseFloat parses a numeric value of Alpha Vantage, or returns nil if it is missing
+func parseFloat(value string) *float32 {
+	f, err := strconv.ParseFloat(value, 32)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil
+	}
+
+	res := float32(f)
+	return &res
+}
+
+// parseInt parses an integer value of Alpha Vantage, or returns nil if it is missing
+func parseInt(value string) *int64 {
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &i
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// names of the sources of fundamental metrics
+const (
+	OverviewMetrics        = "overview"
+	IncomeStatementMetrics = "incomeStatement"
+	BalanceSheetMetrics    = "balanceSheet"
+	CashFlowMetrics        = "cashFlow"
+	EarningsMetrics        = "earnings"
+)
+
+// periods of financial reports
+const (
+	AnnualPeriod    = "annual"
+	QuarterlyPeriod = "quarterly"
+)
+
+// FinancialReport is a single report of a financial statement or of earnings
+type FinancialReport struct {
+	SymbolUuid       string
+	Isin             string
+	Statement        string
+	Period           string
+	FiscalDateEnding time.Time
+	ReportedCurrency string
+	Values           map[string]float64
+	FetchedAt        time.Time
+}
+
+// FinancialStatementResponse is the response of the INCOME_STATEMENT,
+// BALANCE_SHEET and CASH_FLOW functions of Alpha Vantage
+type FinancialStatementResponse struct {
+	Symbol           string              `json:"symbol"`
+	AnnualReports    []map[string]string `json:"annualReports"`
+	QuarterlyReports []map[string]string `json:"quarterlyReports"`
+}
+
+// EarningsResponse is the response of the EARNINGS function of Alpha Vantage
+type EarningsResponse struct {
+	Symbol            string              `json:"symbol"`
+	AnnualEarnings    []map[string]string `json:"annualEarnings"`
+	QuarterlyEarnings []map[string]string `json:"quarterlyEarnings"`
+}
+
+func (s *FinancialStatementResponse) ToEntities(statement string, uuid string, isin string) []FinancialReport {
+	var res []FinancialReport
+	res = append(res, reportsToEntities(s.AnnualReports, statement, AnnualPeriod, uuid, isin)...)
+	res = append(res, reportsToEntities(s.QuarterlyReports, statement, QuarterlyPeriod, uuid, isin)...)
+
+	return res
+}
+
+func (s *EarningsResponse) ToEntities(uuid string, isin string) []FinancialReport {
+	var res []FinancialReport
+	res = append(res, reportsToEntities(s.AnnualEarnings, EarningsMetrics, AnnualPeriod, uuid, isin)...)
+	res = append(res, reportsToEntities(s.QuarterlyEarnings, EarningsMetrics, QuarterlyPeriod, uuid, isin)...)
+
+	return res
+}
+
+// reportsToEntities parses the numeric values of the reports. Values which Alpha Vantage
+// reports as "None" are left out.
+func reportsToEntities(reports []map[string]string, statement string, period string, uuid string, isin string) []FinancialReport {
+	now := time.Now()
+
+	var res []FinancialReport
+	for _, report := range reports {
+		fiscalDateEnding, err := time.Parse("2006-01-02", report["fiscalDateEnding"])
+		if err != nil {
+			continue
+		}
+
+		values := make(map[string]float64)
+		for k, v := range report {
+			if k == "fiscalDateEnding" || k == "reportedCurrency" || k == "reportedDate" {
+				continue
+			}
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			values[k] = f
+		}
+
+		res = append(res, FinancialReport{
+			SymbolUuid:       uuid,
+			Isin:             isin,
+			Statement:        statement,
+			Period:           period,
+			FiscalDateEnding: fiscalDateEnding,
+			ReportedCurrency: report["reportedCurrency"],
+			Values:           values,
+			FetchedAt:        now,
+		})
+	}
+
+	return res
+}
+
+// Metrics returns the numeric values of the overview which are charted over time.
+// Values which weren't reported are left out.
+func (s *InstrumentOverview) Metrics() map[string]float64 {
+	res := make(map[string]float64)
+	for name, v := range map[string]*int64{
+		"MarketCapitalization": s.MarketCapitalization,
+		"EBITDA":               s.EBITDA,
+		"RevenueTTM":           s.RevenueTTM,
+		"GrossProfitTTM":       s.GrossProfitTTM,
+		"SharesOutstanding":    s.SharesOutstanding,
+	} {
+		if v != nil {
+			res[name] = float64(*v)
+		}
+	}
+	for name, v := range map[string]*float32{
+		"PERatio":                    s.PERatio,
+		"PEGRatio":                   s.PEGRatio,
+		"BookValue":                  s.BookValue,
+		"DividendPerShare":           s.DividendPerShare,
+		"DividendYield":              s.DividendYield,
+		"EPS":                        s.EPS,
+		"RevenuePerShareTTM":         s.RevenuePerShareTTM,
+		"ProfitMargin":               s.ProfitMargin,
+		"OperatingMarginTTM":         s.OperatingMarginTTM,
+		"ReturnOnAssetsTTM":          s.ReturnOnAssetsTTM,
+		"ReturnOnEquityTTM":          s.ReturnOnEquityTTM,
+		"DilutedEPSTTM":              s.DilutedEPSTTM,
+		"QuarterlyEarningsGrowthYOY": s.QuarterlyEarningsGrowthYOY,
+		"QuarterlyRevenueGrowthYOY":  s.QuarterlyRevenueGrowthYOY,
+		"AnalystTargetPrice":         s.AnalystTargetPrice,
+		"TrailingPE":                 s.TrailingPE,
+		"ForwardPE":                  s.ForwardPE,
+		"PriceToSalesRatioTTM":       s.PriceToSalesRatioTTM,
+		"PriceToBookRatio":           s.PriceToBookRatio,
+		"EVToRevenue":                s.EVToRevenue,
+		"EVToEBITDA":                 s.EVToEBITDA,
+		"Beta":                       s.Beta,
+		"WeekHigh52":                 s.WeekHigh52,
+		"WeekLow52":                  s.WeekLow52,
+	} {
+		if v != nil {
+			res[name] = float64(*v)
+		}
+	}
+
+	return res
+}
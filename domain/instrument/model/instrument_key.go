@@ -0,0 +1,20 @@
+package model
+
+import "github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+// InstrumentKey identifies instruments by one of uuid, ISIN or identifier and market name
+type InstrumentKey struct {
+	Uuid       string
+	Isin       string
+	Identifier string
+	MarketName string
+}
+
+func (InstrumentKey) FromProtoObject(k *instrument_service.InstrumentKey) InstrumentKey {
+	return InstrumentKey{
+		Uuid:       k.Uuid,
+		Isin:       k.Isin,
+		Identifier: k.Identifier,
+		MarketName: k.MarketName,
+	}
+}
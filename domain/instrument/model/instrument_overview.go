@@ -8,56 +8,59 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// InstrumentOverview is the overview of a security. Numeric values which Alpha Vantage
+// doesn't report are nil, so they are stored as null and never match a screen.
 type InstrumentOverview struct {
 	SymbolUuid                 string
+	Isin                       string
 	Description                string
 	Country                    string
 	Sector                     string
 	Industry                   string
 	Address                    string
-	FullTimeEmployees          int64
+	FullTimeEmployees          *int64
 	FiscalYearEnd              string
 	LatestQuarter              time.Time
-	MarketCapitalization       int64
-	EBITDA                     int64
-	PERatio                    float32
-	PEGRatio                   float32
-	BookValue                  float32
-	DividendPerShare           float32
-	DividendYield              float32
-	EPS                        float32
-	RevenuePerShareTTM         float32
-	ProfitMargin               float32
-	OperatingMarginTTM         float32
-	ReturnOnAssetsTTM          float32
-	ReturnOnEquityTTM          float32
-	RevenueTTM                 int64
-	GrossProfitTTM             int64
-	DilutedEPSTTM              float32
-	QuarterlyEarningsGrowthYOY float32
-	QuarterlyRevenueGrowthYOY  float32
-	AnalystTargetPrice         float32
-	TrailingPE                 float32
-	ForwardPE                  float32
-	PriceToSalesRatioTTM       float32
-	PriceToBookRatio           float32
-	EVToRevenue                float32
-	EVToEBITDA                 float32
-	Beta                       float32
-	WeekHigh52                 float32
-	WeekLow52                  float32
-	SharesOutstanding          int64
-	SharesFloat                int64
-	SharesShort                int64
-	SharesShortPriorMonth      int64
-	ShortRatio                 float32
-	ShortPercentOutstanding    float32
-	ShortPercentFloat          float32
-	PercentInsiders            float32
-	PercentInstitutions        float32
-	ForwardAnnualDividendRate  float32
-	ForwardAnnualDividendYield float32
-	PayoutRatio                float32
+	MarketCapitalization       *int64
+	EBITDA                     *int64
+	PERatio                    *float32
+	PEGRatio                   *float32
+	BookValue                  *float32
+	DividendPerShare           *float32
+	DividendYield              *float32
+	EPS                        *float32
+	RevenuePerShareTTM         *float32
+	ProfitMargin               *float32
+	OperatingMarginTTM         *float32
+	ReturnOnAssetsTTM          *float32
+	ReturnOnEquityTTM          *float32
+	RevenueTTM                 *int64
+	GrossProfitTTM             *int64
+	DilutedEPSTTM              *float32
+	QuarterlyEarningsGrowthYOY *float32
+	QuarterlyRevenueGrowthYOY  *float32
+	AnalystTargetPrice         *float32
+	TrailingPE                 *float32
+	ForwardPE                  *float32
+	PriceToSalesRatioTTM       *float32
+	PriceToBookRatio           *float32
+	EVToRevenue                *float32
+	EVToEBITDA                 *float32
+	Beta                       *float32
+	WeekHigh52                 *float32
+	WeekLow52                  *float32
+	SharesOutstanding          *int64
+	SharesFloat                *int64
+	SharesShort                *int64
+	SharesShortPriorMonth      *int64
+	ShortRatio                 *float32
+	ShortPercentOutstanding    *float32
+	ShortPercentFloat          *float32
+	PercentInsiders            *float32
+	PercentInstitutions        *float32
+	ForwardAnnualDividendRate  *float32
+	ForwardAnnualDividendYield *float32
+	PayoutRatio                *float32
 	DividendDate               time.Time
 	ExDividendDate             time.Time
 	LastSplitFactor            string
@@ -82,53 +85,71 @@ func (s *InstrumentOverview) ToProto() *instrument_service.InstrumentOverview {
 		Sector:                     s.Sector,
 		Industry:                   s.Industry,
 		Address:                    s.Address,
-		FullTimeEmployees:          s.FullTimeEmployees,
+		FullTimeEmployees:          int64Value(s.FullTimeEmployees),
 		FiscalYearEnd:              s.FiscalYearEnd,
 		LatestQuarter:              latestQuarter,
-		MarketCapitalization:       s.MarketCapitalization,
-		Ebitda:                     s.EBITDA,
-		PeRatio:                    s.PERatio,
-		PegRatio:                   s.PEGRatio,
-		BookValue:                  s.BookValue,
-		DividendPerShare:           s.DividendPerShare,
-		DividendYield:              s.DividendYield,
-		Eps:                        s.EPS,
-		RevenuePerShareTtm:         s.RevenuePerShareTTM,
-		ProfitMargin:               s.ProfitMargin,
-		OperatingMarginTtm:         s.OperatingMarginTTM,
-		ReturnOnAssetsTtm:          s.ReturnOnAssetsTTM,
-		ReturnOnEquity:             s.ReturnOnEquityTTM,
-		RevenueTtm:                 s.RevenueTTM,
-		GrossProfitTtm:             s.GrossProfitTTM,
-		DilutedEpsTtm:              s.DilutedEPSTTM,
-		QuarterlyEarningsGrowthYoy: s.QuarterlyEarningsGrowthYOY,
-		QuarterlyRevenueGrowthYoy:  s.QuarterlyRevenueGrowthYOY,
-		AnalystTargetPrice:         s.AnalystTargetPrice,
-		TrailingPe:                 s.TrailingPE,
-		ForwardPe:                  s.ForwardPE,
-		PriceToSalesRatioTtm:       s.PriceToSalesRatioTTM,
-		PriceToBookRatio:           s.PriceToBookRatio,
-		EvToRevenue:                s.EVToRevenue,
-		EvToEbitda:                 s.EVToEBITDA,
-		Beta:                       s.Beta,
-		WeekHigh52:                 s.WeekHigh52,
-		WeekLow52:                  s.WeekLow52,
-		SharesOutstanding:          s.SharesOutstanding,
-		SharesFloat:                s.SharesFloat,
-		SharesShort:                s.SharesShort,
-		SharesShortPriorMonth:      s.SharesShortPriorMonth,
-		ShortRatio:                 s.ShortRatio,
-		ShortPercentOutstanding:    s.ShortPercentOutstanding,
-		ShortPercentFloat:          s.ShortPercentFloat,
-		PercentInsiders:            s.PercentInsiders,
-		PercentInstitutions:        s.PercentInstitutions,
-		ForwardAnnualDividendRate:  s.ForwardAnnualDividendRate,
-		ForwardAnnualDividendYield: s.ForwardAnnualDividendYield,
-		PayoutRatio:                s.PayoutRatio,
+		MarketCapitalization:       int64Value(s.MarketCapitalization),
+		Ebitda:                     int64Value(s.EBITDA),
+		PeRatio:                    float32Value(s.PERatio),
+		PegRatio:                   float32Value(s.PEGRatio),
+		BookValue:                  float32Value(s.BookValue),
+		DividendPerShare:           float32Value(s.DividendPerShare),
+		DividendYield:              float32Value(s.DividendYield),
+		Eps:                        float32Value(s.EPS),
+		RevenuePerShareTtm:         float32Value(s.RevenuePerShareTTM),
+		ProfitMargin:               float32Value(s.ProfitMargin),
+		OperatingMarginTtm:         float32Value(s.OperatingMarginTTM),
+		ReturnOnAssetsTtm:          float32Value(s.ReturnOnAssetsTTM),
+		ReturnOnEquity:             float32Value(s.ReturnOnEquityTTM),
+		RevenueTtm:                 int64Value(s.RevenueTTM),
+		GrossProfitTtm:             int64Value(s.GrossProfitTTM),
+		DilutedEpsTtm:              float32Value(s.DilutedEPSTTM),
+		QuarterlyEarningsGrowthYoy: float32Value(s.QuarterlyEarningsGrowthYOY),
+		QuarterlyRevenueGrowthYoy:  float32Value(s.QuarterlyRevenueGrowthYOY),
+		AnalystTargetPrice:         float32Value(s.AnalystTargetPrice),
+		TrailingPe:                 float32Value(s.TrailingPE),
+		ForwardPe:                  float32Value(s.ForwardPE),
+		PriceToSalesRatioTtm:       float32Value(s.PriceToSalesRatioTTM),
+		PriceToBookRatio:           float32Value(s.PriceToBookRatio),
+		EvToRevenue:                float32Value(s.EVToRevenue),
+		EvToEbitda:                 float32Value(s.EVToEBITDA),
+		Beta:                       float32Value(s.Beta),
+		WeekHigh52:                 float32Value(s.WeekHigh52),
+		WeekLow52:                  float32Value(s.WeekLow52),
+		SharesOutstanding:          int64Value(s.SharesOutstanding),
+		SharesFloat:                int64Value(s.SharesFloat),
+		SharesShort:                int64Value(s.SharesShort),
+		SharesShortPriorMonth:      int64Value(s.SharesShortPriorMonth),
+		ShortRatio:                 float32Value(s.ShortRatio),
+		ShortPercentOutstanding:    float32Value(s.ShortPercentOutstanding),
+		ShortPercentFloat:          float32Value(s.ShortPercentFloat),
+		PercentInsiders:            float32Value(s.PercentInsiders),
+		PercentInstitutions:        float32Value(s.PercentInstitutions),
+		ForwardAnnualDividendRate:  float32Value(s.ForwardAnnualDividendRate),
+		ForwardAnnualDividendYield: float32Value(s.ForwardAnnualDividendYield),
+		PayoutRatio:                float32Value(s.PayoutRatio),
 		DividendDate:               dividendDate,
 		ExDividendDate:             exDividendDate,
 		LastSplitFactor:            s.LastSplitFactor,
 		LastSplitDate:              lastSplitDate,
 		UpdatedAt:                  updatedAt,
+		FetchedAt:                  updatedAt,
+		Stale:                      s.ShouldUpdate(),
 	}
 }
+
+func float32Value(v *float32) float32 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
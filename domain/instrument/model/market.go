@@ -0,0 +1,45 @@
+package model
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Market struct {
+	ID             uint
+	Name           string
+	TickerSuffix   string
+	CurrencyCode   string
+	Calendar       string
+	SyncEnabled    bool
+	HistoryEnabled bool
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+func (Market) FromProtoObject(m *instrument_service.Market) *Market {
+	return &Market{
+		ID:             uint(m.Id),
+		Name:           m.Name,
+		TickerSuffix:   m.TickerSuffix,
+		CurrencyCode:   m.CurrencyCode,
+		Calendar:       m.Calendar,
+		SyncEnabled:    m.SyncEnabled,
+		HistoryEnabled: m.HistoryEnabled,
+	}
+}
+
+func (m *Market) ToProto() *instrument_service.Market {
+	return &instrument_service.Market{
+		Id:             uint64(m.ID),
+		Name:           m.Name,
+		TickerSuffix:   m.TickerSuffix,
+		CurrencyCode:   m.CurrencyCode,
+		Calendar:       m.Calendar,
+		SyncEnabled:    m.SyncEnabled,
+		HistoryEnabled: m.HistoryEnabled,
+		CreatedAt:      timestamppb.New(m.CreatedAt.Time),
+		UpdatedAt:      timestamppb.New(m.UpdatedAt.Time),
+	}
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// QuotaWindow is a limit on the number of requests made to a provider during a period
+type QuotaWindow struct {
+	Period string
+	Length time.Duration
+	Limit  int
+}
+
+// Start returns the start of the window the time falls in
+func (w QuotaWindow) Start(t time.Time) time.Time {
+	return t.UTC().Truncate(w.Length)
+}
+
+// QuotaUsage is the number of requests made to a provider during a window
+type QuotaUsage struct {
+	Provider    string
+	Period      string
+	WindowStart time.Time
+	Used        int32
+	Limit       int32
+}
+
+func (u *QuotaUsage) ToProto() *instrument_service.ProviderQuota {
+	return &instrument_service.ProviderQuota{
+		Provider:    u.Provider,
+		Period:      u.Period,
+		WindowStart: timestamppb.New(u.WindowStart),
+		Used:        u.Used,
+		Limit:       u.Limit,
+	}
+}
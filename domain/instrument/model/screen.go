@@ -0,0 +1,25 @@
+package model
+
+import "github.com/vectorman1/analysis/analysis-api/common"
+
+// FundamentalsScreen is the specification of a screen over the stored overviews
+type FundamentalsScreen struct {
+	Filters []ScreenFilter
+	Sort    []ScreenSort
+
+	PageSize   uint64
+	PageNumber uint64
+}
+
+// ScreenFilter is a filter on a field of the overviews, with values parsed to the field type
+type ScreenFilter struct {
+	Field    string
+	Operator common.FilterOperator
+	Values   []interface{}
+	Numeric  bool
+}
+
+type ScreenSort struct {
+	Field     string
+	Ascending bool
+}
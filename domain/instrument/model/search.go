@@ -0,0 +1,43 @@
+package model
+
+import "github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+// InstrumentSearch is the specification of an instrument search
+type InstrumentSearch struct {
+	Query      string
+	Markets    []string
+	Currencies []string
+
+	// Restricted limits the results to the securities in Isins and the listings in Uuids
+	Restricted bool
+	Isins      []string
+	Uuids      []string
+
+	PageSize   uint64
+	PageNumber uint64
+}
+
+type SearchResult struct {
+	Symbol Symbol
+	Score  float32
+}
+
+func (r *SearchResult) ToProto() *instrument_service.SearchResult {
+	return &instrument_service.SearchResult{
+		Instrument: r.Symbol.ToProto(),
+		Score:      r.Score,
+	}
+}
+
+// Facet is the number of search results with a value of a field
+type Facet struct {
+	Value string
+	Count uint64
+}
+
+func (f *Facet) ToProto() *instrument_service.Facet {
+	return &instrument_service.Facet{
+		Value: f.Value,
+		Count: f.Count,
+	}
+}
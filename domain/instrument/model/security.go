@@ -0,0 +1,31 @@
+package model
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Security is an instrument identified by its ISIN, which can have listings
+// on multiple markets and in multiple currencies
+type Security struct {
+	ID                 uint
+	Isin               string
+	Name               string
+	PrimaryListingUuid pgtype.UUID
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+}
+
+func (s *Security) ToProto() *instrument_service.Security {
+	var u string
+	s.PrimaryListingUuid.AssignTo(&u)
+
+	return &instrument_service.Security{
+		Isin:               s.Isin,
+		Name:               s.Name,
+		PrimaryListingUuid: u,
+		CreatedAt:          timestamppb.New(s.CreatedAt.Time),
+		UpdatedAt:          timestamppb.New(s.UpdatedAt.Time),
+	}
+}
@@ -17,6 +17,7 @@ type Symbol struct {
 	MinimumOrderQuantity pgtype.Float4 `json:"minimum_order_quantity"`
 	MarketName           string        `json:"market_name"`
 	MarketHoursGmt       string        `json:"market_hours_gmt"`
+	Source               string        `json:"source"`
 
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
@@ -39,6 +40,7 @@ func (Symbol) FromProtoObject(sym *instrument_service.Instrument) *Symbol {
 		MinimumOrderQuantity: moq,
 		MarketName:           sym.MarketName,
 		MarketHoursGmt:       sym.MarketHoursGmt,
+		Source:               sym.Source,
 	}
 
 	return res
@@ -61,6 +63,7 @@ func (s *Symbol) ToProto() *instrument_service.Instrument {
 		CreatedAt:            timestamppb.New(s.CreatedAt.Time),
 		UpdatedAt:            timestamppb.New(s.UpdatedAt.Time),
 		DeletedAt:            timestamppb.New(s.DeletedAt.Time),
+		Source:               s.Source,
 	}
 
 	return res
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SymbolRevision struct {
+	ID                     uint
+	SymbolUuid             pgtype.UUID
+	Type                   instrument_service.InstrumentStatusResponseType
+	Name                   string
+	PreviousName           pgtype.Text
+	MarketHoursGmt         string
+	PreviousMarketHoursGmt pgtype.Text
+	CreatedAt              pgtype.Timestamptz
+}
+
+func (r *SymbolRevision) ToProto() *instrument_service.InstrumentRevision {
+	var u string
+	r.SymbolUuid.AssignTo(&u)
+
+	return &instrument_service.InstrumentRevision{
+		Id:                     uint64(r.ID),
+		InstrumentUuid:         u,
+		Type:                   r.Type,
+		Name:                   r.Name,
+		PreviousName:           r.PreviousName.String,
+		MarketHoursGmt:         r.MarketHoursGmt,
+		PreviousMarketHoursGmt: r.PreviousMarketHoursGmt.String,
+		CreatedAt:              timestamppb.New(r.CreatedAt.Time),
+	}
+}
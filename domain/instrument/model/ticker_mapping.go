@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TickerMapping struct {
+	ID         uint
+	SymbolUuid pgtype.UUID
+	Provider   string
+	Ticker     string
+	Source     string
+	Failed     bool
+	Error      pgtype.Text
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+
+	// Stored is set when the mapping was read from the database
+	Stored bool
+}
+
+func (m *TickerMapping) ToProto() *instrument_service.TickerMapping {
+	var u string
+	m.SymbolUuid.AssignTo(&u)
+
+	return &instrument_service.TickerMapping{
+		InstrumentUuid: u,
+		Provider:       m.Provider,
+		Ticker:         m.Ticker,
+		Source:         m.Source,
+		Failed:         m.Failed,
+		Error:          m.Error.String,
+		UpdatedAt:      timestamppb.New(m.UpdatedAt.Time),
+	}
+}
@@ -0,0 +1,39 @@
+package present
+
+import (
+	"fmt"
+	"net/http"
+
+	service2 "github.com/vectorman1/analysis/analysis-api/domain/instrument/service"
+)
+
+// QuotaMetricsHandler serves the usage of the data provider quotas in the Prometheus text format
+type QuotaMetricsHandler struct {
+	overviewRefresher *service2.OverviewRefresher
+}
+
+func NewQuotaMetricsHandler(overviewRefresher *service2.OverviewRefresher) *QuotaMetricsHandler {
+	return &QuotaMetricsHandler{
+		overviewRefresher: overviewRefresher,
+	}
+}
+
+func (h *QuotaMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	usage, err := h.overviewRefresher.QuotaUsage(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	fmt.Fprintln(w, "# HELP provider_quota_used Requests made to the data provider in the current window.")
+	fmt.Fprintln(w, "# TYPE provider_quota_used gauge")
+	for _, u := range usage {
+		fmt.Fprintf(w, "provider_quota_used{provider=%q,period=%q} %d\n", u.Provider, u.Period, u.Used)
+	}
+	fmt.Fprintln(w, "# HELP provider_quota_limit Requests allowed to the data provider per window.")
+	fmt.Fprintln(w, "# TYPE provider_quota_limit gauge")
+	for _, u := range usage {
+		fmt.Fprintf(w, "provider_quota_limit{provider=%q,period=%q} %d\n", u.Provider, u.Period, u.Limit)
+	}
+}
@@ -15,16 +15,24 @@ import (
 )
 
 type InstrumentServiceServer struct {
-	rabbitClient   *common.RabbitClient
-	symbolService  *service2.InstrumentsService
-	historyService *service2.HistoryService
+	rabbitClient         *common.RabbitClient
+	symbolService        *service2.InstrumentsService
+	historyService       *service2.HistoryService
+	marketService        *service2.MarketService
+	tickerMappingService *service2.TickerMappingService
 	instrument_service.UnimplementedInstrumentServiceServer
 }
 
-func NewSymbolServiceServer(symbolsService *service2.InstrumentsService, historyService *service2.HistoryService) *InstrumentServiceServer {
+func NewSymbolServiceServer(
+	symbolsService *service2.InstrumentsService,
+	historyService *service2.HistoryService,
+	marketService *service2.MarketService,
+	tickerMappingService *service2.TickerMappingService) *InstrumentServiceServer {
 	return &InstrumentServiceServer{
-		symbolService:  symbolsService,
-		historyService: historyService,
+		symbolService:        symbolsService,
+		historyService:       historyService,
+		marketService:        marketService,
+		tickerMappingService: tickerMappingService,
 	}
 }
 
@@ -40,18 +48,30 @@ func (s *InstrumentServiceServer) GetPaged(
 	timeoutContext, c := context.WithTimeout(ctx, 5*time.Second)
 	defer c()
 
-	res, totalItemsCount, err := s.symbolService.GetPaged(timeoutContext, req)
+	res, totalItemsCount, nextPageToken, err := s.symbolService.GetPaged(timeoutContext, req)
 	if err != nil {
 		return nil, common.GetErrorStatus(err)
 	}
 
 	resp := &instrument_service.PagedResponse{
-		Items:      *res,
-		TotalItems: uint64(totalItemsCount),
+		Items:         *res,
+		TotalItems:    uint64(totalItemsCount),
+		NextPageToken: nextPageToken,
 	}
 	return resp, nil
 }
 
+func (s *InstrumentServiceServer) ListAll(
+	req *instrument_service.ListAllRequest,
+	stream instrument_service.InstrumentService_ListAllServer) error {
+	err := s.symbolService.ListAll(stream.Context(), req, stream.Send)
+	if err != nil {
+		return common.GetErrorStatus(err)
+	}
+
+	return nil
+}
+
 func (s *InstrumentServiceServer) Overview(
 	ctx context.Context,
 	req *instrument_service.InstrumentRequest) (*instrument_service.InstrumentOverview, error) {
@@ -63,6 +83,182 @@ func (s *InstrumentServiceServer) Overview(
 	return res, nil
 }
 
+func (s *InstrumentServiceServer) Revisions(
+	ctx context.Context,
+	req *instrument_service.InstrumentRequest) (*instrument_service.RevisionsResponse, error) {
+	res, err := s.symbolService.Revisions(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) UploadInstruments(
+	ctx context.Context,
+	req *instrument_service.UploadInstrumentsRequest) (*instrument_service.UploadInstrumentsResponse, error) {
+	res, err := s.symbolService.UploadInstruments(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) ListMarkets(
+	ctx context.Context,
+	req *instrument_service.ListMarketsRequest) (*instrument_service.ListMarketsResponse, error) {
+	res, err := s.marketService.List(ctx)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) CreateMarket(
+	ctx context.Context,
+	req *instrument_service.Market) (*instrument_service.Market, error) {
+	res, err := s.marketService.Create(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) UpdateMarket(
+	ctx context.Context,
+	req *instrument_service.Market) (*instrument_service.Market, error) {
+	res, err := s.marketService.Update(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) DeleteMarket(
+	ctx context.Context,
+	req *instrument_service.MarketRequest) (*instrument_service.DeleteMarketResponse, error) {
+	res, err := s.marketService.Delete(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) Listings(
+	ctx context.Context,
+	req *instrument_service.InstrumentRequest) (*instrument_service.ListingsResponse, error) {
+	res, err := s.symbolService.Listings(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) Fundamentals(
+	ctx context.Context,
+	req *instrument_service.FundamentalsRequest) (*instrument_service.FundamentalsResponse, error) {
+	res, err := s.symbolService.Fundamentals(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) ScreenFundamentals(
+	ctx context.Context,
+	req *instrument_service.ScreenFundamentalsRequest) (*instrument_service.ScreenFundamentalsResponse, error) {
+	res, err := s.symbolService.ScreenFundamentals(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) ProviderQuotas(
+	ctx context.Context,
+	req *instrument_service.ProviderQuotasRequest) (*instrument_service.ProviderQuotasResponse, error) {
+	res, err := s.symbolService.ProviderQuotas(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) Peers(
+	ctx context.Context,
+	req *instrument_service.PeersRequest) (*instrument_service.PeersResponse, error) {
+	res, err := s.symbolService.Peers(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) SetPrimaryListing(
+	ctx context.Context,
+	req *instrument_service.SetPrimaryListingRequest) (*instrument_service.Security, error) {
+	res, err := s.symbolService.SetPrimaryListing(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) Search(
+	ctx context.Context,
+	req *instrument_service.SearchRequest) (*instrument_service.SearchResponse, error) {
+	res, err := s.symbolService.Search(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) BatchGet(
+	ctx context.Context,
+	req *instrument_service.BatchGetRequest) (*instrument_service.BatchGetResponse, error) {
+	res, err := s.symbolService.BatchGet(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) SetTickerMapping(
+	ctx context.Context,
+	req *instrument_service.TickerMapping) (*instrument_service.TickerMapping, error) {
+	res, err := s.tickerMappingService.Set(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
+func (s *InstrumentServiceServer) MappingFailures(
+	ctx context.Context,
+	req *instrument_service.MappingFailuresRequest) (*instrument_service.MappingFailuresResponse, error) {
+	res, err := s.tickerMappingService.Failures(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return res, nil
+}
+
 func (s *InstrumentServiceServer) UpdateAllJob(
 	ctx context.Context,
 	req *instrument_service.StartUpdateJobRequest) (*instrument_service.StartUpdateJobResponse, error) {
@@ -0,0 +1,142 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type FundamentalsRepositoryContract interface {
+	InsertSnapshot(ctx context.Context, overview *model.InstrumentOverview) error
+	GetSnapshots(ctx context.Context, uuid string, isin string, start time.Time, end time.Time) ([]model.InstrumentOverview, error)
+	UpsertReports(ctx context.Context, reports []model.FinancialReport) error
+	GetReports(ctx context.Context, uuid string, isin string, statement string, period string, start time.Time, end time.Time) ([]model.FinancialReport, error)
+	GetLastFetchedAt(ctx context.Context, uuid string, isin string) (time.Time, error)
+}
+
+// FundamentalsRepository stores the overview snapshots and financial reports of securities,
+// keyed by ISIN or by symbol uuid for symbols without one
+type FundamentalsRepository struct {
+	mongodb *mongo.Database
+}
+
+func NewFundamentalsRepository(mongodb *mongo.Database) *FundamentalsRepository {
+	return &FundamentalsRepository{
+		mongodb: mongodb,
+	}
+}
+
+// InsertSnapshot adds the overview to the time series of the security's overviews
+func (r *FundamentalsRepository) InsertSnapshot(ctx context.Context, overview *model.InstrumentOverview) error {
+	_, err := r.mongodb.Collection(common.OverviewSnapshotsCollection).
+		InsertOne(ctx, overview)
+
+	return err
+}
+
+// GetSnapshots returns the overview snapshots fetched between start and end, oldest first
+func (r *FundamentalsRepository) GetSnapshots(
+	ctx context.Context,
+	uuid string,
+	isin string,
+	start time.Time,
+	end time.Time) ([]model.InstrumentOverview, error) {
+	filter := fundamentalsKey(uuid, isin)
+	filter["updatedat"] = bson.M{"$gte": start, "$lte": end}
+
+	cur, err := r.mongodb.Collection(common.OverviewSnapshotsCollection).
+		Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "updatedat", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []model.InstrumentOverview
+	if err = cur.All(ctx, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UpsertReports stores the reports, replacing the ones of the same statement, period and fiscal date
+func (r *FundamentalsRepository) UpsertReports(ctx context.Context, reports []model.FinancialReport) error {
+	if len(reports) == 0 {
+		return nil
+	}
+
+	var models []mongo.WriteModel
+	for i := range reports {
+		filter := fundamentalsKey(reports[i].SymbolUuid, reports[i].Isin)
+		filter["statement"] = reports[i].Statement
+		filter["period"] = reports[i].Period
+		filter["fiscaldateending"] = reports[i].FiscalDateEnding
+
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(filter).
+			SetReplacement(&reports[i]).
+			SetUpsert(true))
+	}
+
+	_, err := r.mongodb.Collection(common.FinancialReportsCollection).
+		BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	return err
+}
+
+// GetReports returns the reports of the statement and period which ended between start and end, oldest first
+func (r *FundamentalsRepository) GetReports(
+	ctx context.Context,
+	uuid string,
+	isin string,
+	statement string,
+	period string,
+	start time.Time,
+	end time.Time) ([]model.FinancialReport, error) {
+	filter := fundamentalsKey(uuid, isin)
+	filter["statement"] = statement
+	filter["period"] = period
+	filter["fiscaldateending"] = bson.M{"$gte": start, "$lte": end}
+
+	cur, err := r.mongodb.Collection(common.FinancialReportsCollection).
+		Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "fiscaldateending", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []model.FinancialReport
+	if err = cur.All(ctx, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetLastFetchedAt returns when the reports of the security were last fetched, or the zero time if never
+func (r *FundamentalsRepository) GetLastFetchedAt(ctx context.Context, uuid string, isin string) (time.Time, error) {
+	var report model.FinancialReport
+	err := r.mongodb.Collection(common.FinancialReportsCollection).
+		FindOne(ctx, fundamentalsKey(uuid, isin), options.FindOne().SetSort(bson.D{{Key: "fetchedat", Value: -1}})).
+		Decode(&report)
+	if err == mongo.ErrNoDocuments {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return report.FetchedAt, nil
+}
+
+func fundamentalsKey(uuid string, isin string) bson.M {
+	if isin != "" {
+		return bson.M{"isin": isin}
+	}
+
+	return bson.M{"symboluuid": uuid}
+}
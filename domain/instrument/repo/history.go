@@ -51,9 +51,9 @@ func (r *HistoryRepository) InsertMany(ctx context.Context, list *[]model.Histor
 func (r *HistoryRepository) GetSymbolHistory(ctx context.Context, symbolUuid string, startDate time.Time, endDate time.Time, desc bool) ([]model.History, error) {
 	opts := options.Find()
 	if desc {
-		opts.SetSort(bson.D{{"timestamp", -1}})
+		opts.SetSort(bson.D{{Key: "timestamp", Value: -1}})
 	} else {
-		opts.SetSort(bson.D{{"timestamp", 1}})
+		opts.SetSort(bson.D{{Key: "timestamp", Value: 1}})
 	}
 	filter := bson.M{
 		"symboluuid": symbolUuid,
@@ -139,6 +139,48 @@ func (r *HistoryRepository) GetLastSymbolHistory(ctx context.Context, symbolUuid
 	return &res, nil
 }
 
+// GetCloses returns the closing prices of the symbols since the given time, oldest first
+func (r *HistoryRepository) GetCloses(ctx context.Context, symbolUuids []string, since time.Time) (map[string][]model.LastHistory, error) {
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"symboluuid": bson.M{"$in": symbolUuids},
+				"timestamp":  bson.M{"$gte": since},
+			},
+		},
+		{
+			"$sort": bson.D{{Key: "timestamp", Value: 1}},
+		},
+		{
+			"$group": bson.M{
+				"_id":    "$symboluuid",
+				"closes": bson.M{"$push": bson.M{"close": "$close", "timestamp": "$timestamp"}},
+			},
+		},
+	}
+
+	cur, err := r.mongodb.Collection(common.HistoriesCollection).
+		Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	result := make(map[string][]model.LastHistory)
+	for cur.Next(ctx) {
+		var group struct {
+			SymbolUuid string              `bson:"_id"`
+			Closes     []model.LastHistory `bson:"closes"`
+		}
+		if err = cur.Decode(&group); err != nil {
+			return nil, err
+		}
+		result[group.SymbolUuid] = group.Closes
+	}
+
+	return result, nil
+}
+
 func (r *HistoryRepository) translateToMongoMatch(request model.TADigestRequest) bson.M {
 	result := make(bson.M)
 
@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+)
+
+type InstrumentUploadRepositoryContract interface {
+	Insert(ctx context.Context, content string) error
+	GetLatest(ctx context.Context) (string, error)
+}
+
+type InstrumentUploadRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewInstrumentUploadRepository(db *pgx.ConnPool) *InstrumentUploadRepository {
+	return &InstrumentUploadRepository{
+		db: db,
+	}
+}
+
+// Insert stores a manually uploaded CSV listing of instruments
+func (r *InstrumentUploadRepository) Insert(ctx context.Context, content string) error {
+	query, args, err := squirrel.
+		Insert("analysis.instrument_uploads").
+		Columns("content, createdAt").
+		Values(content, time.Now()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// GetLatest returns the content of the most recent upload,
+// or an empty string if nothing has been uploaded yet
+func (r *InstrumentUploadRepository) GetLatest(ctx context.Context) (string, error) {
+	query, args, err := squirrel.
+		Select("content").
+		From("analysis.instrument_uploads").
+		OrderBy("createdAt desc").
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var content string
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(&content)
+	if err == pgx.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return content, nil
+}
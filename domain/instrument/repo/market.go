@@ -0,0 +1,153 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+)
+
+type MarketRepositoryContract interface {
+	GetAll(ctx context.Context) (*[]model.Market, error)
+	GetByName(ctx context.Context, name string) (*model.Market, error)
+	Create(ctx context.Context, market *model.Market) error
+	Update(ctx context.Context, market *model.Market) error
+	Delete(ctx context.Context, name string) (bool, error)
+}
+
+type MarketRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewMarketRepository(db *pgx.ConnPool) *MarketRepository {
+	return &MarketRepository{
+		db: db,
+	}
+}
+
+// GetAll returns all markets in the registry, ordered by name
+func (r *MarketRepository) GetAll(ctx context.Context) (*[]model.Market, error) {
+	query, args, err := squirrel.
+		Select("id, name, tickerSuffix, currencyCode, calendar, syncEnabled, historyEnabled, createdAt, updatedAt").
+		From("analysis.markets").
+		OrderBy("name asc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.Market
+	for rows.Next() {
+		m := model.Market{}
+		if err = rows.Scan(
+			&m.ID,
+			&m.Name,
+			&m.TickerSuffix,
+			&m.CurrencyCode,
+			&m.Calendar,
+			&m.SyncEnabled,
+			&m.HistoryEnabled,
+			&m.CreatedAt,
+			&m.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+
+	return &result, nil
+}
+
+func (r *MarketRepository) GetByName(ctx context.Context, name string) (*model.Market, error) {
+	query, args, err := squirrel.
+		Select("id, name, tickerSuffix, currencyCode, calendar, syncEnabled, historyEnabled, createdAt, updatedAt").
+		From("analysis.markets").
+		Where(squirrel.Eq{"name": name}).
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	m := model.Market{}
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(
+		&m.ID,
+		&m.Name,
+		&m.TickerSuffix,
+		&m.CurrencyCode,
+		&m.Calendar,
+		&m.SyncEnabled,
+		&m.HistoryEnabled,
+		&m.CreatedAt,
+		&m.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+func (r *MarketRepository) Create(ctx context.Context, market *model.Market) error {
+	now := time.Now()
+	query, args, err := squirrel.
+		Insert("analysis.markets").
+		Columns("name, tickerSuffix, currencyCode, calendar, syncEnabled, historyEnabled, createdAt, updatedAt").
+		Values(market.Name, market.TickerSuffix, market.CurrencyCode, market.Calendar, market.SyncEnabled, market.HistoryEnabled, now, now).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// Update sets all values of the market with the matching name
+func (r *MarketRepository) Update(ctx context.Context, market *model.Market) error {
+	query, args, err := squirrel.
+		Update("analysis.markets").
+		Set("tickerSuffix", market.TickerSuffix).
+		Set("currencyCode", market.CurrencyCode).
+		Set("calendar", market.Calendar).
+		Set("syncEnabled", market.SyncEnabled).
+		Set("historyEnabled", market.HistoryEnabled).
+		Set("updatedAt", time.Now()).
+		Where(squirrel.Eq{"name": market.Name}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// Delete removes the market with the matching name and returns whether it existed
+func (r *MarketRepository) Delete(ctx context.Context, name string) (bool, error) {
+	query, args, err := squirrel.
+		Delete("analysis.markets").
+		Where(squirrel.Eq{"name": name}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	res, err := r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+)
+
+type ProviderQuotaRepositoryContract interface {
+	Take(ctx context.Context, provider string, windows []model.QuotaWindow, now time.Time) (*model.QuotaWindow, error)
+	GetUsage(ctx context.Context, provider string, windows []model.QuotaWindow, now time.Time) ([]model.QuotaUsage, error)
+	DeleteBefore(ctx context.Context, provider string, before time.Time) error
+}
+
+// ProviderQuotaRepository counts the requests made to providers, so
+// their quotas are shared by all replicas of the service
+type ProviderQuotaRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewProviderQuotaRepository(db *pgx.ConnPool) *ProviderQuotaRepository {
+	return &ProviderQuotaRepository{
+		db: db,
+	}
+}
+
+// Take counts a request in the current window of each of the windows. If one of them is
+// exhausted nothing is counted and the exhausted window is returned.
+func (r *ProviderQuotaRepository) Take(
+	ctx context.Context,
+	provider string,
+	windows []model.QuotaWindow,
+	now time.Time) (*model.QuotaWindow, error) {
+	tx, err := r.db.BeginEx(ctx, &pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range windows {
+		query, args, err := squirrel.
+			Insert("analysis.provider_quota_usage").
+			Columns("provider, period, windowStart, used").
+			Values(provider, windows[i].Period, windows[i].Start(now), 1).
+			Suffix("ON CONFLICT (provider, period, windowStart) DO UPDATE "+
+				"SET used = provider_quota_usage.used + 1 WHERE provider_quota_usage.used < ? RETURNING used",
+				windows[i].Limit).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			tx.RollbackEx(ctx)
+			return nil, err
+		}
+
+		var used int32
+		err = tx.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(&used)
+		if err == pgx.ErrNoRows {
+			tx.RollbackEx(ctx)
+			return &windows[i], nil
+		}
+		if err != nil {
+			tx.RollbackEx(ctx)
+			return nil, err
+		}
+	}
+
+	return nil, tx.CommitEx(ctx)
+}
+
+// GetUsage returns the number of requests made to the provider in the current window of each of the windows
+func (r *ProviderQuotaRepository) GetUsage(
+	ctx context.Context,
+	provider string,
+	windows []model.QuotaWindow,
+	now time.Time) ([]model.QuotaUsage, error) {
+	var res []model.QuotaUsage
+	for _, w := range windows {
+		query, args, err := squirrel.
+			Select("used").
+			From("analysis.provider_quota_usage").
+			Where(squirrel.Eq{"provider": provider, "period": w.Period, "windowStart": w.Start(now)}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		usage := model.QuotaUsage{
+			Provider:    provider,
+			Period:      w.Period,
+			WindowStart: w.Start(now),
+			Limit:       int32(w.Limit),
+		}
+		err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(&usage.Used)
+		if err != nil && err != pgx.ErrNoRows {
+			return nil, err
+		}
+		res = append(res, usage)
+	}
+
+	return res, nil
+}
+
+// DeleteBefore removes the counts of the provider's windows which started before the given time
+func (r *ProviderQuotaRepository) DeleteBefore(ctx context.Context, provider string, before time.Time) error {
+	query, args, err := squirrel.
+		Delete("analysis.provider_quota_usage").
+		Where(squirrel.Eq{"provider": provider}).
+		Where(squirrel.Lt{"windowStart": before}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, nil, args...)
+	return err
+}
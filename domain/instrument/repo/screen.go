@@ -0,0 +1,275 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var screenOperators = map[common.FilterOperator]string{
+	common.OperatorEq:  "$eq",
+	common.OperatorNeq: "$ne",
+	common.OperatorGt:  "$gt",
+	common.OperatorGte: "$gte",
+	common.OperatorLt:  "$lt",
+	common.OperatorLte: "$lte",
+	common.OperatorIn:  "$in",
+}
+
+// Screen returns the overviews of the given securities and symbols matching the filters of the screen
+// in its order, starting at the offset
+func (r *SymbolOverviewRepository) Screen(
+	ctx context.Context,
+	screen *model.FundamentalsScreen,
+	isins []string,
+	uuids []string,
+	offset uint64,
+	limit uint64) ([]model.InstrumentOverview, error) {
+	sort := bson.D{}
+	for _, s := range screen.Sort {
+		if s.Ascending {
+			sort = append(sort, bson.E{Key: s.Field, Value: 1})
+		} else {
+			sort = append(sort, bson.E{Key: s.Field, Value: -1})
+		}
+	}
+	sort = append(sort, bson.E{Key: "_id", Value: 1})
+
+	cur, err := r.mondodb.Collection(common.OverviewsCollection).
+		Find(
+			ctx,
+			screenMatch(screen, keysMatch(isins, uuids)),
+			options.Find().
+				SetSort(sort).
+				SetSkip(int64(offset)).
+				SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []model.InstrumentOverview
+	if err = cur.All(ctx, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CountScreen returns the number of overviews of the given securities and symbols matching the screen
+func (r *SymbolOverviewRepository) CountScreen(
+	ctx context.Context,
+	screen *model.FundamentalsScreen,
+	isins []string,
+	uuids []string) (uint64, error) {
+	count, err := r.mondodb.Collection(common.OverviewsCollection).
+		CountDocuments(ctx, screenMatch(screen, keysMatch(isins, uuids)))
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(count), nil
+}
+
+// keysMatch matches the overviews of the securities with the ISINs and of the symbols with the uuids
+func keysMatch(isins []string, uuids []string) bson.M {
+	if isins == nil {
+		isins = []string{}
+	}
+	if uuids == nil {
+		uuids = []string{}
+	}
+
+	return bson.M{
+		"$or": []bson.M{
+			{"isin": bson.M{"$in": isins}},
+			{"symboluuid": bson.M{"$in": uuids}},
+		},
+	}
+}
+
+// screenMatch adds the filters of the screen to the match. Missing values are stored as null,
+// which only $ne matches, so numeric fields compared with it also have to be numbers.
+func screenMatch(screen *model.FundamentalsScreen, match bson.M) bson.M {
+	var and []bson.M
+	for _, f := range screen.Filters {
+		switch {
+		case f.Operator == common.OperatorIn:
+			and = append(and, bson.M{f.Field: bson.M{"$in": f.Values}})
+		case f.Operator == common.OperatorNeq && f.Numeric:
+			and = append(and, bson.M{f.Field: bson.M{"$ne": f.Values[0], "$type": "number"}})
+		default:
+			and = append(and, bson.M{f.Field: bson.M{screenOperators[f.Operator]: f.Values[0]}})
+		}
+	}
+	if len(and) > 0 {
+		match["$and"] = and
+	}
+
+	return match
+}
+
+// GetKeysPage returns a page of the keys of the symbols matching the conditions, ordered by uuid and
+// starting after the given one: the ISINs of the symbols which have one, the uuids of the rest, and
+// the uuid to continue after, which is empty on the last page
+func (r *SymbolRepository) GetKeysPage(
+	ctx context.Context,
+	conditions squirrel.Sqlizer,
+	after string,
+	limit uint64) ([]string, []string, string, error) {
+	builder := squirrel.
+		Select("uuid::text", "isin").
+		From("analysis.symbols").
+		Where("deletedAt is NULL").
+		Where(conditions).
+		OrderBy("uuid").
+		Limit(limit)
+	if after != "" {
+		builder = builder.Where("uuid > ?::uuid", after)
+	}
+	query, args, err := builder.
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	defer rows.Close()
+
+	var uuids, isins []string
+	var u, isin string
+	var count uint64
+	for rows.Next() {
+		if err = rows.Scan(&u, &isin); err != nil {
+			return nil, nil, "", err
+		}
+		count++
+		if isin != "" {
+			isins = append(isins, isin)
+		} else {
+			uuids = append(uuids, u)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, "", err
+	}
+	if count < limit {
+		u = ""
+	}
+
+	return uuids, isins, u, nil
+}
+
+// GetKeys returns the keys of all the symbols matching the conditions: the ISINs of the securities
+// with a matching listing, each once, and the uuids of the matching symbols without an ISIN
+func (r *SymbolRepository) GetKeys(
+	ctx context.Context,
+	conditions squirrel.Sqlizer) ([]string, []string, error) {
+	query, args, err := squirrel.
+		Select("DISTINCT CASE WHEN isin = '' THEN uuid::text ELSE '' END", "isin").
+		From("analysis.symbols").
+		Where("deletedAt is NULL").
+		Where(conditions).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var uuids, isins []string
+	var u, isin string
+	for rows.Next() {
+		if err = rows.Scan(&u, &isin); err != nil {
+			return nil, nil, err
+		}
+		if isin != "" {
+			isins = append(isins, isin)
+		} else {
+			uuids = append(uuids, u)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return uuids, isins, nil
+}
+
+// GetScreenListings returns the symbols matching the conditions which are in the uuids, or are
+// the primary listing among those matching of a security in the ISINs
+func (r *SymbolRepository) GetScreenListings(
+	ctx context.Context,
+	uuids []string,
+	isins []string,
+	conditions squirrel.Sqlizer) (*[]model.Symbol, error) {
+	matching := squirrel.
+		Select("*").
+		From("analysis.symbols").
+		Where("deletedAt is NULL").
+		Where(conditions).
+		Where(squirrel.Or{
+			squirrel.Eq{"uuid::text": uuids},
+			squirrel.Eq{"isin": isins},
+		})
+
+	query, args, err := squirrel.
+		Select("DISTINCT ON (s.isin, CASE WHEN s.isin = '' THEN s.uuid END) s.*").
+		FromSelect(matching, "s").
+		LeftJoin("analysis.securities sec ON sec.isin = s.isin").
+		LeftJoin("analysis.markets m ON m.name = s.marketName").
+		OrderBy(
+			"s.isin",
+			"CASE WHEN s.isin = '' THEN s.uuid END",
+			"(s.uuid = sec.primaryListingUuid) desc nulls last",
+			"m.syncEnabled desc nulls last",
+			"s.createdAt asc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.Symbol
+	for rows.Next() {
+		sym := model.Symbol{}
+		if err = rows.Scan(
+			&sym.ID,
+			&sym.Uuid,
+			&sym.CurrencyCode,
+			&sym.Isin,
+			&sym.Identifier,
+			&sym.Name,
+			&sym.MinimumOrderQuantity,
+			&sym.MarketName,
+			&sym.MarketHoursGmt,
+			&sym.CreatedAt,
+			&sym.UpdatedAt,
+			&sym.DeletedAt,
+			&sym.Source); err != nil {
+			return nil, err
+		}
+		result = append(result, sym)
+	}
+
+	return &result, nil
+}
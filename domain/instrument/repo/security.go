@@ -0,0 +1,191 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+)
+
+type SecurityRepositoryContract interface {
+	GetByIsin(ctx context.Context, isin string) (*model.Security, error)
+	GetListings(ctx context.Context, isin string) (*[]model.Symbol, error)
+	GetPrimaryListing(ctx context.Context, isin string) (*model.Symbol, error)
+	InsertBulk(tx *pgx.Tx, ctx context.Context, symbols []*model.Symbol) error
+	SetPrimaryListing(ctx context.Context, isin string, symbolUuid string) (bool, error)
+}
+
+type SecurityRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewSecurityRepository(db *pgx.ConnPool) *SecurityRepository {
+	return &SecurityRepository{
+		db: db,
+	}
+}
+
+func (r *SecurityRepository) GetByIsin(ctx context.Context, isin string) (*model.Security, error) {
+	query, args, err := squirrel.
+		Select("id, isin, name, primaryListingUuid, createdAt, updatedAt").
+		From("analysis.securities").
+		Where(squirrel.Eq{"isin": isin}).
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	sec := model.Security{}
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(
+		&sec.ID,
+		&sec.Isin,
+		&sec.Name,
+		&sec.PrimaryListingUuid,
+		&sec.CreatedAt,
+		&sec.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sec, nil
+}
+
+// GetListings returns the listings of the security which aren't deleted, ordered by market
+func (r *SecurityRepository) GetListings(ctx context.Context, isin string) (*[]model.Symbol, error) {
+	query, args, err := squirrel.
+		Select("*").
+		From("analysis.symbols").
+		Where(squirrel.Eq{"isin": isin}).
+		Where("deletedAt is NULL").
+		OrderBy("marketName asc", "currencyCode asc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.Symbol
+	for rows.Next() {
+		sym := model.Symbol{}
+		if err = rows.Scan(
+			&sym.ID,
+			&sym.Uuid,
+			&sym.CurrencyCode,
+			&sym.Isin,
+			&sym.Identifier,
+			&sym.Name,
+			&sym.MinimumOrderQuantity,
+			&sym.MarketName,
+			&sym.MarketHoursGmt,
+			&sym.CreatedAt,
+			&sym.UpdatedAt,
+			&sym.DeletedAt,
+			&sym.Source); err != nil {
+			return nil, err
+		}
+		result = append(result, sym)
+	}
+
+	return &result, nil
+}
+
+// GetPrimaryListing returns the listing the shared data of the security is fetched for.
+// The primary listing chosen for the security is used when it isn't deleted, otherwise
+// the oldest listing on a market enabled for sync is picked.
+func (r *SecurityRepository) GetPrimaryListing(ctx context.Context, isin string) (*model.Symbol, error) {
+	query, args, err := squirrel.
+		Select("s.*").
+		From("analysis.symbols s").
+		LeftJoin("analysis.securities sec ON sec.isin = s.isin").
+		LeftJoin("analysis.markets m ON m.name = s.marketName").
+		Where(squirrel.Eq{"s.isin": isin}).
+		Where("s.deletedAt is NULL").
+		OrderBy("(s.uuid = sec.primaryListingUuid) desc nulls last", "m.syncEnabled desc nulls last", "s.createdAt asc").
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	sym := model.Symbol{}
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(
+		&sym.ID,
+		&sym.Uuid,
+		&sym.CurrencyCode,
+		&sym.Isin,
+		&sym.Identifier,
+		&sym.Name,
+		&sym.MinimumOrderQuantity,
+		&sym.MarketName,
+		&sym.MarketHoursGmt,
+		&sym.CreatedAt,
+		&sym.UpdatedAt,
+		&sym.DeletedAt,
+		&sym.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sym, nil
+}
+
+// InsertBulk creates the securities of the symbols' ISINs which don't exist yet
+func (r *SecurityRepository) InsertBulk(tx *pgx.Tx, ctx context.Context, symbols []*model.Symbol) error {
+	now := time.Now()
+	seen := make(map[string]bool)
+	q := squirrel.
+		Insert("analysis.securities").
+		Columns("isin, name, createdAt, updatedAt").
+		Suffix("ON CONFLICT (isin) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar)
+	for _, sym := range symbols {
+		if sym.Isin == "" || seen[sym.Isin] {
+			continue
+		}
+		seen[sym.Isin] = true
+		q = q.Values(sym.Isin, sym.Name, now, now)
+	}
+	if len(seen) == 0 {
+		return nil
+	}
+
+	query, args, err := q.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// SetPrimaryListing sets the primary listing of the security and returns whether it exists
+func (r *SecurityRepository) SetPrimaryListing(ctx context.Context, isin string, symbolUuid string) (bool, error) {
+	query, args, err := squirrel.
+		Update("analysis.securities").
+		Set("primaryListingUuid", squirrel.Expr("?::uuid", symbolUuid)).
+		Set("updatedAt", time.Now()).
+		Where(squirrel.Eq{"isin": isin}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	res, err := r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
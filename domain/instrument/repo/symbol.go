@@ -19,11 +19,15 @@ import (
 )
 
 type SymbolRepo interface {
-	GetPaged(ctx context.Context, req *instrument_service.PagedRequest) (*[]model.Symbol, uint, error)
+	GetPaged(ctx context.Context, pagedQuery *common.PagedQuery) (*[]model.Symbol, uint, string, error)
 	GetByUuid(ctx context.Context, uuid string) (*model.Symbol, error)
+	GetByKeys(ctx context.Context, keys []model.InstrumentKey) (map[int][]model.Symbol, error)
+	GetDeleted(ctx context.Context) (*[]model.Symbol, error)
 	InsertBulk(tx *pgx.Tx, ctx context.Context, symbols []*model.Symbol) (bool, error)
 	DeleteBulk(tx *pgx.Tx, ctx context.Context, symbols []*model.Symbol) (bool, error)
 	UpdateBulk(tx *pgx.Tx, ctx context.Context, symbols []*model.Symbol) (bool, error)
+	RestoreBulk(tx *pgx.Tx, ctx context.Context, symbols []*model.Symbol) (bool, error)
+	GetRevisions(ctx context.Context, symbolUuid string) (*[]model.SymbolRevision, error)
 
 	BeginTx(ctx *context.Context, options *pgx.TxOptions) (*pgx.Tx, error)
 }
@@ -38,42 +42,40 @@ func NewSymbolRepository(db *pgx.ConnPool) *SymbolRepository {
 	}
 }
 
-// GetPaged returns a paged response of symbols stored
-func (r *SymbolRepository) GetPaged(ctx context.Context, req *instrument_service.PagedRequest) (*[]model.Symbol, uint, error) {
+// GetPaged returns a paged response of symbols stored, the total number of matching symbols
+// and the token of the next page
+func (r *SymbolRepository) GetPaged(ctx context.Context, pagedQuery *common.PagedQuery) (*[]model.Symbol, uint, string, error) {
 	// generate query
-	order := common.FormatOrderQuery(req.Filter.Order, req.Filter.Ascending)
-	queryBuilder := squirrel.
-		Select("*, count(*) OVER() AS total_count").
+	queryBuilder := pagedQuery.Apply(squirrel.
+		Select("*").
 		From("analysis.symbols").
-		OrderBy(order).
-		Offset((req.Filter.PageNumber - 1) * req.Filter.PageSize).
-		Limit(req.Filter.PageSize).
 		Where("deletedAt is NULL").
-		PlaceholderFormat(squirrel.Dollar)
+		PlaceholderFormat(squirrel.Dollar))
 
-	if req.Filter.Text != "" {
-		req.Filter.Text = fmt.Sprintf("%%%s%%", req.Filter.Text)
-		nameLikeText := squirrel.ILike{"name": req.Filter.Text}
-		identifierLikeText := squirrel.ILike{"identifier": req.Filter.Text}
-		isinLikeText := squirrel.ILike{"isin": req.Filter.Text}
+	if pagedQuery.Text != "" {
+		text := fmt.Sprintf("%%%s%%", pagedQuery.Text)
+		nameLikeText := squirrel.ILike{"name": text}
+		identifierLikeText := squirrel.ILike{"identifier": text}
+		isinLikeText := squirrel.ILike{"isin": text}
 
 		queryBuilder = queryBuilder.Where(squirrel.Or{nameLikeText, identifierLikeText, isinLikeText})
 	}
 
 	q, args, err := queryBuilder.ToSql()
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	rows, err := r.db.QueryEx(ctx, q, nil, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 	defer rows.Close()
 
 	// read all resulting rows
 	var result []model.Symbol
 	var totalItems uint
+	var cursor string
 	for rows.Next() {
 		sym := model.Symbol{}
 		if err = rows.Scan(
@@ -89,13 +91,15 @@ func (r *SymbolRepository) GetPaged(ctx context.Context, req *instrument_service
 			&sym.CreatedAt,
 			&sym.UpdatedAt,
 			&sym.DeletedAt,
-			&totalItems); err != nil {
-			return nil, 0, err
+			&sym.Source,
+			&totalItems,
+			&cursor); err != nil {
+			return nil, 0, "", err
 		}
 		result = append(result, sym)
 	}
 
-	return &result, totalItems, nil
+	return &result, totalItems, pagedQuery.NextPageToken(len(result), cursor), nil
 }
 
 func (r *SymbolRepository) GetByUuid(ctx context.Context, symbolUuid string) (*model.Symbol, error) {
@@ -104,18 +108,19 @@ func (r *SymbolRepository) GetByUuid(ctx context.Context, symbolUuid string) (*m
 		return nil, err
 	}
 
-	queryBuilder := squirrel.
+	query, args, err := squirrel.
 		Select("*").
 		From("analysis.symbols").
-		Where(fmt.Sprintf("uuid = '%s'", u.String())).
-		Limit(1)
-	query, _, err := queryBuilder.ToSql()
+		Where("uuid = ?::uuid", u.String()).
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
 	sym := model.Symbol{}
-	row := r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{})
+	row := r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...)
 	if err = row.Scan(
 		&sym.ID,
 		&sym.Uuid,
@@ -128,13 +133,163 @@ func (r *SymbolRepository) GetByUuid(ctx context.Context, symbolUuid string) (*m
 		&sym.MarketHoursGmt,
 		&sym.CreatedAt,
 		&sym.UpdatedAt,
-		&sym.DeletedAt); err != nil {
+		&sym.DeletedAt,
+		&sym.Source); err != nil {
 		return nil, err
 	}
 
 	return &sym, nil
 }
 
+// GetByKeys returns the symbols which aren't deleted matching each of the keys, by the index of the key.
+// All keys are resolved in a single query, which joins the symbols with the unnested key columns once
+// per kind of key, so each join can use the index of its column.
+func (r *SymbolRepository) GetByKeys(ctx context.Context, keys []model.InstrumentKey) (map[int][]model.Symbol, error) {
+	var indexes []int32
+	var uuids []*string
+	var isins, identifiers, marketNames []string
+	for i, k := range keys {
+		indexes = append(indexes, int32(i))
+		if k.Uuid != "" {
+			u := k.Uuid
+			uuids = append(uuids, &u)
+		} else {
+			uuids = append(uuids, nil)
+		}
+		isins = append(isins, k.Isin)
+		identifiers = append(identifiers, k.Identifier)
+		marketNames = append(marketNames, k.MarketName)
+	}
+
+	matching := func(on string) squirrel.SelectBuilder {
+		return squirrel.
+			Select("k.idx", "s.*").
+			From("analysis.symbols s").
+			Join("k ON " + on).
+			Where("s.deletedAt is NULL")
+	}
+	query, args, err := matching("s.uuid = k.uuid").
+		Prefix(
+			"WITH k(idx, uuid, isin, identifier, marketName) AS "+
+				"(SELECT * FROM unnest(?::int[], ?::uuid[], ?::text[], ?::text[], ?::text[]))",
+			indexes, uuids, isins, identifiers, marketNames).
+		SuffixExpr(squirrel.Expr(
+			"UNION ? UNION ? ORDER BY idx asc, marketName asc",
+			matching("s.isin = NULLIF(k.isin, '')"),
+			matching("s.identifier = NULLIF(k.identifier, '') AND s.marketName = k.marketName"))).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int][]model.Symbol)
+	for rows.Next() {
+		var idx int32
+		sym := model.Symbol{}
+		if err = rows.Scan(
+			&idx,
+			&sym.ID,
+			&sym.Uuid,
+			&sym.CurrencyCode,
+			&sym.Isin,
+			&sym.Identifier,
+			&sym.Name,
+			&sym.MinimumOrderQuantity,
+			&sym.MarketName,
+			&sym.MarketHoursGmt,
+			&sym.CreatedAt,
+			&sym.UpdatedAt,
+			&sym.DeletedAt,
+			&sym.Source); err != nil {
+			return nil, err
+		}
+		result[int(idx)] = append(result[int(idx)], sym)
+	}
+
+	return result, nil
+}
+
+// GetIsins returns the ISINs of the symbols with the given uuids, by uuid. Symbols without an ISIN are left out.
+func (r *SymbolRepository) GetIsins(ctx context.Context, uuids []string) (map[string]string, error) {
+	query, args, err := squirrel.
+		Select("uuid::text", "isin").
+		From("analysis.symbols").
+		Where(squirrel.Eq{"uuid::text": uuids}).
+		Where("isin <> ''").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[string]string)
+	for rows.Next() {
+		var u, isin string
+		if err = rows.Scan(&u, &isin); err != nil {
+			return nil, err
+		}
+		res[u] = isin
+	}
+
+	return res, rows.Err()
+}
+
+// GetDeleted returns all symbols which have been soft deleted
+func (r *SymbolRepository) GetDeleted(ctx context.Context) (*[]model.Symbol, error) {
+	query, args, err := squirrel.
+		Select("*").
+		From("analysis.symbols").
+		Where("deletedAt is NOT NULL").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.Symbol
+	for rows.Next() {
+		sym := model.Symbol{}
+		if err = rows.Scan(
+			&sym.ID,
+			&sym.Uuid,
+			&sym.CurrencyCode,
+			&sym.Isin,
+			&sym.Identifier,
+			&sym.Name,
+			&sym.MinimumOrderQuantity,
+			&sym.MarketName,
+			&sym.MarketHoursGmt,
+			&sym.CreatedAt,
+			&sym.UpdatedAt,
+			&sym.DeletedAt,
+			&sym.Source); err != nil {
+			return nil, err
+		}
+		result = append(result, sym)
+	}
+
+	return &result, nil
+}
+
 // InsertBulk inserts the slice in a single transaction in batches and returns success and error
 func (r *SymbolRepository) InsertBulk(tx *pgx.Tx, ctx context.Context, symbols []*model.Symbol) (bool, error) {
 	// split inserts in batches
@@ -159,7 +314,7 @@ func (r *SymbolRepository) InsertBulk(tx *pgx.Tx, ctx context.Context, symbols [
 	for list := range workList {
 		q := squirrel.
 			Insert("analysis.symbols").
-			Columns("uuid, currencyCode, isin, identifier, name, minimumOrderQuantity, marketName, marketHoursGmt, createdAt, updatedAt, deletedAt").
+			Columns("uuid, currencyCode, isin, identifier, name, minimumOrderQuantity, marketName, marketHoursGmt, createdAt, updatedAt, deletedAt, source").
 			PlaceholderFormat(squirrel.Dollar)
 		for _, sym := range list {
 			q = q.Values(
@@ -173,7 +328,8 @@ func (r *SymbolRepository) InsertBulk(tx *pgx.Tx, ctx context.Context, symbols [
 				&sym.MarketHoursGmt,
 				now,
 				now,
-				&pgtype.Timestamptz{Status: pgtype.Null})
+				&pgtype.Timestamptz{Status: pgtype.Null},
+				&sym.Source)
 		}
 
 		query, args, _ := q.ToSql()
@@ -183,6 +339,28 @@ func (r *SymbolRepository) InsertBulk(tx *pgx.Tx, ctx context.Context, symbols [
 				return false, err
 			}
 		}
+
+		// record the creation of each symbol in the revisions table. Revisions take their
+		// createdAt from the database clock, like those of updates, so they are ordered consistently.
+		rq := squirrel.
+			Insert("analysis.symbol_revisions").
+			Columns("symbolUuid, type, name, marketHoursGmt").
+			PlaceholderFormat(squirrel.Dollar)
+		for _, sym := range list {
+			rq = rq.Values(
+				&sym.Uuid,
+				int32(instrument_service.InstrumentStatus_CREATE),
+				&sym.Name,
+				&sym.MarketHoursGmt)
+		}
+
+		query, args, _ = rq.ToSql()
+		if len(args) > 0 {
+			_, err := tx.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+			if err != nil {
+				return false, err
+			}
+		}
 	}
 
 	return true, nil
@@ -215,6 +393,15 @@ func (r *SymbolRepository) DeleteBulk(tx *pgx.Tx, ctx context.Context, symbols [
 			var u string
 			sym.Uuid.AssignTo(&u)
 
+			// record the symbol as it was before deleting it
+			err := r.insertRevision(tx, ctx, u, squirrel.
+				Select("uuid").
+				Column(squirrel.Expr("?::smallint", int32(instrument_service.InstrumentStatus_DELETE))).
+				Column("name, name, marketHoursGmt, marketHoursGmt"))
+			if err != nil {
+				return false, err
+			}
+
 			q := squirrel.Update("analysis.symbols")
 
 			q = q.
@@ -262,13 +449,27 @@ func (r *SymbolRepository) UpdateBulk(tx *pgx.Tx, ctx context.Context, symbols [
 			var u string
 			sym.Uuid.AssignTo(&u)
 
+			// record the new values alongside the ones being overwritten
+			err := r.insertRevision(tx, ctx, u, squirrel.
+				Select("uuid").
+				Column(squirrel.Expr("?::smallint", int32(instrument_service.InstrumentStatus_UPDATE))).
+				Column(squirrel.Expr("?::text", sym.Name)).
+				Column("name").
+				Column(squirrel.Expr("?::text", sym.MarketHoursGmt)).
+				Column("marketHoursGmt"))
+			if err != nil {
+				return false, err
+			}
+
 			q := squirrel.
 				Update("analysis.symbols").
 				PlaceholderFormat(squirrel.Dollar)
 
 			q = q.
 				Set("name", sym.Name).
+				Set("isin", sym.Isin).
 				Set("marketHoursGmt", sym.MarketHoursGmt).
+				Set("source", sym.Source).
 				Set("updatedAt", now).
 				Where(squirrel.Eq{"uuid::text": u})
 
@@ -285,6 +486,109 @@ func (r *SymbolRepository) UpdateBulk(tx *pgx.Tx, ctx context.Context, symbols [
 	return true, nil
 }
 
+// RestoreBulk clears the Deleted At values of bulk symbols and updates
+// their columns with the passed symbol values
+func (r *SymbolRepository) RestoreBulk(tx *pgx.Tx, ctx context.Context, symbols []*model.Symbol) (bool, error) {
+	now := time.Now()
+	for _, sym := range symbols {
+		var u string
+		sym.Uuid.AssignTo(&u)
+
+		err := r.insertRevision(tx, ctx, u, squirrel.
+			Select("uuid").
+			Column(squirrel.Expr("?::smallint", int32(instrument_service.InstrumentStatus_RESTORE))).
+			Column(squirrel.Expr("?::text", sym.Name)).
+			Column("name").
+			Column(squirrel.Expr("?::text", sym.MarketHoursGmt)).
+			Column("marketHoursGmt"))
+		if err != nil {
+			return false, err
+		}
+
+		query, args, _ := squirrel.
+			Update("analysis.symbols").
+			Set("name", sym.Name).
+			Set("isin", sym.Isin).
+			Set("marketHoursGmt", sym.MarketHoursGmt).
+			Set("source", sym.Source).
+			Set("updatedAt", now).
+			Set("deletedAt", &pgtype.Timestamptz{Status: pgtype.Null}).
+			Where(squirrel.Eq{"uuid::text": u}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		_, err = tx.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// GetRevisions returns the revision timeline of the symbol with the given uuid,
+// ordered from the oldest to the newest change
+func (r *SymbolRepository) GetRevisions(ctx context.Context, symbolUuid string) (*[]model.SymbolRevision, error) {
+	u, err := uuid.FromString(symbolUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := squirrel.
+		Select("id, symbolUuid, type, name, previousName, marketHoursGmt, previousMarketHoursGmt, createdAt").
+		From("analysis.symbol_revisions").
+		Where(squirrel.Eq{"symbolUuid::text": u.String()}).
+		OrderBy("createdAt asc", "id asc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.SymbolRevision
+	for rows.Next() {
+		rev := model.SymbolRevision{}
+		if err = rows.Scan(
+			&rev.ID,
+			&rev.SymbolUuid,
+			&rev.Type,
+			&rev.Name,
+			&rev.PreviousName,
+			&rev.MarketHoursGmt,
+			&rev.PreviousMarketHoursGmt,
+			&rev.CreatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, rev)
+	}
+
+	return &result, nil
+}
+
+// insertRevision stores a revision for the symbol with the given uuid, with
+// the values selected by sel from the symbol's current row
+func (r *SymbolRepository) insertRevision(tx *pgx.Tx, ctx context.Context, symbolUuid string, sel squirrel.SelectBuilder) error {
+	query, args, err := squirrel.
+		Insert("analysis.symbol_revisions").
+		Columns("symbolUuid, type, name, previousName, marketHoursGmt, previousMarketHoursGmt").
+		Select(sel.
+			From("analysis.symbols").
+			Where(squirrel.Eq{"uuid::text": symbolUuid})).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
 // BeginTx starts a new transaction on the given context
 func (r *SymbolRepository) BeginTx(ctx *context.Context, options *pgx.TxOptions) (*pgx.Tx, error) {
 	tx, err := r.db.BeginEx(*ctx, options)
@@ -9,12 +9,15 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type SymbolOverviewContract interface {
 	Insert(ctx context.Context, overview *model.InstrumentOverviewResponse) (bool, error)
 	GetBySymbolUuid(ctx context.Context, uuid string) (*model.InstrumentOverviewResponse, error)
+	GetByIsin(ctx context.Context, isin string) (*model.InstrumentOverview, error)
 	Delete(ctx context.Context, uuid string) error
+	Upsert(ctx context.Context, overview *model.InstrumentOverview) error
 }
 
 type SymbolOverviewRepository struct {
@@ -49,6 +52,202 @@ func (r *SymbolOverviewRepository) GetByInstrumentUuid(ctx context.Context, uuid
 	return &overview, nil
 }
 
+// GetByIsin returns the overview shared by the listings of the security with the given ISIN
+func (r *SymbolOverviewRepository) GetByIsin(ctx context.Context, isin string) (*model.InstrumentOverview, error) {
+	var overview model.InstrumentOverview
+	err := r.mondodb.Collection(common.OverviewsCollection).
+		FindOne(ctx, bson.M{"isin": isin}).
+		Decode(&overview)
+	if err != nil {
+		return nil, err
+	}
+
+	return &overview, nil
+}
+
+// Upsert replaces the stored overview of the security, or of the symbol if it has no ISIN,
+// in a single operation so the previous overview is kept if it fails
+func (r *SymbolOverviewRepository) Upsert(ctx context.Context, overview *model.InstrumentOverview) error {
+	filter := bson.M{"symboluuid": overview.SymbolUuid}
+	if overview.Isin != "" {
+		filter = bson.M{"isin": overview.Isin}
+	}
+
+	_, err := r.mondodb.Collection(common.OverviewsCollection).
+		ReplaceOne(ctx, filter, overview, options.Replace().SetUpsert(true))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetUuidsWithoutIsin returns the symbol uuids of the overviews which were stored before
+// overviews were shared by the listings of a security, and aren't keyed by an ISIN
+func (r *SymbolOverviewRepository) GetUuidsWithoutIsin(ctx context.Context) ([]string, error) {
+	cur, err := r.mondodb.Collection(common.OverviewsCollection).
+		Find(
+			ctx,
+			bson.M{"isin": bson.M{"$in": []interface{}{nil, ""}}},
+			options.Find().SetProjection(bson.M{"symboluuid": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	uuids := []string{}
+	for cur.Next(ctx) {
+		var overview model.InstrumentOverview
+		if err = cur.Decode(&overview); err != nil {
+			return nil, err
+		}
+		uuids = append(uuids, overview.SymbolUuid)
+	}
+
+	return uuids, cur.Err()
+}
+
+// SetIsin keys the overview of the symbol by the ISIN of its security. If the security
+// already has an overview, the symbol's one is removed instead of duplicating it.
+func (r *SymbolOverviewRepository) SetIsin(ctx context.Context, symbolUuid string, isin string) error {
+	collection := r.mondodb.Collection(common.OverviewsCollection)
+	legacy := bson.M{"symboluuid": symbolUuid, "isin": bson.M{"$in": []interface{}{nil, ""}}}
+
+	count, err := collection.CountDocuments(ctx, bson.M{"isin": isin})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		_, err = collection.DeleteOne(ctx, legacy)
+		return err
+	}
+
+	_, err = collection.UpdateOne(ctx, legacy, bson.M{"$set": bson.M{"isin": isin}})
+	return err
+}
+
+// GetKeysByCategories returns the ISINs and symbol uuids of the overviews in any of the
+// sectors and countries. Empty categories are not filtered on.
+func (r *SymbolOverviewRepository) GetKeysByCategories(ctx context.Context, sectors []string, countries []string) ([]string, []string, error) {
+	cur, err := r.mondodb.Collection(common.OverviewsCollection).
+		Find(
+			ctx,
+			categoriesFilter(bson.M{}, sectors, countries),
+			options.Find().SetProjection(bson.M{"isin": 1, "symboluuid": 1}))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cur.Close(ctx)
+
+	isins := []string{}
+	uuids := []string{}
+	for cur.Next(ctx) {
+		var overview model.InstrumentOverview
+		if err = cur.Decode(&overview); err != nil {
+			return nil, nil, err
+		}
+		if overview.Isin != "" {
+			isins = append(isins, overview.Isin)
+		}
+		uuids = append(uuids, overview.SymbolUuid)
+	}
+
+	return isins, uuids, nil
+}
+
+// CountByField returns the number of overviews of the given securities and symbols per value of the field
+func (r *SymbolOverviewRepository) CountByField(
+	ctx context.Context,
+	field string,
+	isins []string,
+	uuids []string,
+	sectors []string,
+	countries []string) (*[]model.Facet, error) {
+	if isins == nil {
+		isins = []string{}
+	}
+	if uuids == nil {
+		uuids = []string{}
+	}
+
+	match := categoriesFilter(bson.M{
+		"$or": []bson.M{
+			{"isin": bson.M{"$in": isins}},
+			{"symboluuid": bson.M{"$in": uuids}},
+		},
+	}, sectors, countries)
+	match[field] = bson.M{"$nin": []string{"", "None"}}
+
+	pipeline := []bson.M{
+		{
+			"$match": match,
+		},
+		{
+			"$group": bson.M{
+				"_id":   "$" + field,
+				"count": bson.M{"$sum": 1},
+			},
+		},
+		{
+			"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}},
+		},
+	}
+
+	cur, err := r.mondodb.Collection(common.OverviewsCollection).
+		Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var result []model.Facet
+	for cur.Next(ctx) {
+		var group struct {
+			Value string `bson:"_id"`
+			Count int64  `bson:"count"`
+		}
+		if err = cur.Decode(&group); err != nil {
+			return nil, err
+		}
+		result = append(result, model.Facet{Value: group.Value, Count: uint64(group.Count)})
+	}
+
+	return &result, nil
+}
+
+// GetByIndustry returns the overviews of the industry with the largest market capitalization first
+func (r *SymbolOverviewRepository) GetByIndustry(ctx context.Context, industry string, limit int64) ([]model.InstrumentOverview, error) {
+	cur, err := r.mondodb.Collection(common.OverviewsCollection).
+		Find(
+			ctx,
+			bson.M{"industry": industry},
+			options.Find().
+				SetSort(bson.D{{Key: "marketcapitalization", Value: -1}, {Key: "_id", Value: 1}}).
+				SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []model.InstrumentOverview
+	if err = cur.All(ctx, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// categoriesFilter adds the sector and country filters to the filter
+func categoriesFilter(filter bson.M, sectors []string, countries []string) bson.M {
+	if len(sectors) > 0 {
+		filter["sector"] = bson.M{"$in": sectors}
+	}
+	if len(countries) > 0 {
+		filter["country"] = bson.M{"$in": countries}
+	}
+
+	return filter
+}
+
 func (r *SymbolOverviewRepository) Delete(ctx context.Context, uuid string) error {
 	_, err := r.mondodb.Collection(common.OverviewsCollection).
 		DeleteOne(ctx, bson.M{"symboluuid": uuid})
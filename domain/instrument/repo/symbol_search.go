@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+)
+
+const searchDocument = "to_tsvector('simple', identifier || ' ' || name)"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchConditions builds the conditions of the search. The filter on the
+// exclude column is left out, so facets also count the values not selected.
+func searchConditions(search *model.InstrumentSearch, exclude string) squirrel.And {
+	q := search.Query
+	conditions := squirrel.And{
+		squirrel.Expr("deletedAt is NULL"),
+		squirrel.Expr(
+			"("+searchDocument+" @@ plainto_tsquery('simple', ?)"+
+				" OR identifier ILIKE ?"+
+				" OR identifier % ?"+
+				" OR ? <% name"+
+				" OR isin = upper(?))",
+			q, likeEscaper.Replace(q)+"%", q, q, q),
+	}
+
+	if len(search.Markets) > 0 && exclude != "marketName" {
+		conditions = append(conditions, squirrel.Eq{"marketName": search.Markets})
+	}
+	if len(search.Currencies) > 0 && exclude != "currencyCode" {
+		conditions = append(conditions, squirrel.Eq{"currencyCode": search.Currencies})
+	}
+	if search.Restricted {
+		conditions = append(conditions, squirrel.Or{
+			squirrel.Eq{"isin": search.Isins},
+			squirrel.Eq{"uuid::text": search.Uuids},
+		})
+	}
+
+	return conditions
+}
+
+// Search returns a page of the symbols matching the search and the total number of matches.
+// Exact ticker matches come first, followed by the rest ordered by relevance.
+func (r *SymbolRepository) Search(ctx context.Context, search *model.InstrumentSearch) (*[]model.SearchResult, uint, error) {
+	q := search.Query
+	query, args, err := squirrel.
+		Select("*").
+		Column(squirrel.Expr(
+			"greatest(similarity(identifier, ?), word_similarity(?, name)) + "+
+				"ts_rank("+searchDocument+", plainto_tsquery('simple', ?)) AS score",
+			q, q, q)).
+		Column("count(*) OVER() AS total_count").
+		From("analysis.symbols").
+		Where(searchConditions(search, "")).
+		OrderByClause("upper(identifier) = upper(?) desc", q).
+		OrderBy("score desc", "identifier asc").
+		Offset((search.PageNumber - 1) * search.PageSize).
+		Limit(search.PageSize).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []model.SearchResult
+	var totalItems uint
+	for rows.Next() {
+		res := model.SearchResult{}
+		if err = rows.Scan(
+			&res.Symbol.ID,
+			&res.Symbol.Uuid,
+			&res.Symbol.CurrencyCode,
+			&res.Symbol.Isin,
+			&res.Symbol.Identifier,
+			&res.Symbol.Name,
+			&res.Symbol.MinimumOrderQuantity,
+			&res.Symbol.MarketName,
+			&res.Symbol.MarketHoursGmt,
+			&res.Symbol.CreatedAt,
+			&res.Symbol.UpdatedAt,
+			&res.Symbol.DeletedAt,
+			&res.Symbol.Source,
+			&res.Score,
+			&totalItems); err != nil {
+			return nil, 0, err
+		}
+		result = append(result, res)
+	}
+
+	return &result, totalItems, nil
+}
+
+// SearchFacet returns the number of symbols matching the search per value of the column
+func (r *SymbolRepository) SearchFacet(ctx context.Context, search *model.InstrumentSearch, column string) (*[]model.Facet, error) {
+	query, args, err := squirrel.
+		Select(column+" AS value", "count(*) AS count").
+		From("analysis.symbols").
+		Where(searchConditions(search, column)).
+		GroupBy(column).
+		OrderBy("count desc", "value asc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.Facet
+	for rows.Next() {
+		f := model.Facet{}
+		var count int64
+		if err = rows.Scan(&f.Value, &count); err != nil {
+			return nil, err
+		}
+		f.Count = uint64(count)
+		result = append(result, f)
+	}
+
+	return &result, nil
+}
+
+// SearchKeysPage returns a page of the keys of the symbols matching the search, as GetKeysPage does
+func (r *SymbolRepository) SearchKeysPage(
+	ctx context.Context,
+	search *model.InstrumentSearch,
+	after string,
+	limit uint64) ([]string, []string, string, error) {
+	return r.GetKeysPage(ctx, searchConditions(search, ""), after, limit)
+}
@@ -0,0 +1,124 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+)
+
+type TickerMappingRepositoryContract interface {
+	Get(ctx context.Context, symbolUuid string, provider string) (*model.TickerMapping, error)
+	GetFailed(ctx context.Context, provider string) (*[]model.TickerMapping, error)
+	Upsert(ctx context.Context, mapping *model.TickerMapping) error
+}
+
+type TickerMappingRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewTickerMappingRepository(db *pgx.ConnPool) *TickerMappingRepository {
+	return &TickerMappingRepository{
+		db: db,
+	}
+}
+
+// Get returns the stored mapping of the symbol for the provider
+func (r *TickerMappingRepository) Get(ctx context.Context, symbolUuid string, provider string) (*model.TickerMapping, error) {
+	query, args, err := squirrel.
+		Select("id, symbolUuid, provider, ticker, source, failed, error, createdAt, updatedAt").
+		From("analysis.ticker_mappings").
+		Where(squirrel.Eq{"symbolUuid::text": symbolUuid, "provider": provider}).
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	m := model.TickerMapping{Stored: true}
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(
+		&m.ID,
+		&m.SymbolUuid,
+		&m.Provider,
+		&m.Ticker,
+		&m.Source,
+		&m.Failed,
+		&m.Error,
+		&m.CreatedAt,
+		&m.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// GetFailed returns the mappings of symbols which are not deleted and
+// couldn't be resolved for the provider, or for any provider if it's empty
+func (r *TickerMappingRepository) GetFailed(ctx context.Context, provider string) (*[]model.TickerMapping, error) {
+	queryBuilder := squirrel.
+		Select("m.id, m.symbolUuid, m.provider, m.ticker, m.source, m.failed, m.error, m.createdAt, m.updatedAt").
+		From("analysis.ticker_mappings m").
+		Join("analysis.symbols s ON s.uuid = m.symbolUuid").
+		Where("m.failed").
+		Where("s.deletedAt is NULL").
+		OrderBy("m.updatedAt desc").
+		PlaceholderFormat(squirrel.Dollar)
+	if provider != "" {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"m.provider": provider})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.TickerMapping
+	for rows.Next() {
+		m := model.TickerMapping{Stored: true}
+		if err = rows.Scan(
+			&m.ID,
+			&m.SymbolUuid,
+			&m.Provider,
+			&m.Ticker,
+			&m.Source,
+			&m.Failed,
+			&m.Error,
+			&m.CreatedAt,
+			&m.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+
+	return &result, nil
+}
+
+// Upsert creates or replaces the mapping of the symbol for the provider
+func (r *TickerMappingRepository) Upsert(ctx context.Context, mapping *model.TickerMapping) error {
+	now := time.Now()
+	query, args, err := squirrel.
+		Insert("analysis.ticker_mappings").
+		Columns("symbolUuid, provider, ticker, source, failed, error, createdAt, updatedAt").
+		Values(&mapping.SymbolUuid, mapping.Provider, mapping.Ticker, mapping.Source, mapping.Failed, &mapping.Error, now, now).
+		Suffix("ON CONFLICT (symbolUuid, provider) DO UPDATE SET " +
+			"ticker = EXCLUDED.ticker, source = EXCLUDED.source, failed = EXCLUDED.failed, " +
+			"error = EXCLUDED.error, updatedAt = EXCLUDED.updatedAt").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
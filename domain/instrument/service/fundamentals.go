@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	validationErrors "github.com/vectorman1/analysis/analysis-api/common/errors"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// reports are fetched again once they are older than this, as a new quarter may have been reported
+const reportsMaxAge = 7 * 24 * time.Hour
+
+const maxFundamentalsMetrics = 20
+
+var defaultFundamentalsMetrics = []string{
+	"overview.PERatio",
+	"overview.EPS",
+	"overview.ProfitMargin",
+	"overview.OperatingMarginTTM",
+	"overview.MarketCapitalization",
+}
+
+var fundamentalsSources = map[string]bool{
+	model.OverviewMetrics:        true,
+	model.IncomeStatementMetrics: true,
+	model.BalanceSheetMetrics:    true,
+	model.CashFlowMetrics:        true,
+	model.EarningsMetrics:        true,
+}
+
+// Fundamentals returns the requested metrics of the instrument's security over time. Overview
+// metrics come from the snapshots of its overview, the rest from its financial reports, which
+// are fetched in the background when missing or stale.
+func (s *InstrumentsService) Fundamentals(
+	ctx context.Context,
+	req *instrument_service.FundamentalsRequest) (*instrument_service.FundamentalsResponse, error) {
+	metrics := req.Metrics
+	if len(metrics) == 0 {
+		metrics = defaultFundamentalsMetrics
+	}
+	if len(metrics) > maxFundamentalsMetrics {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d metrics can be requested", maxFundamentalsMetrics)
+	}
+
+	// metrics of the same source are read together
+	bySource := make(map[string][]string)
+	for _, metric := range metrics {
+		parts := strings.SplitN(metric, ".", 2)
+		if len(parts) != 2 || parts[1] == "" || !fundamentalsSources[parts[0]] {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid metric %s", metric)
+		}
+		bySource[parts[0]] = append(bySource[parts[0]], parts[1])
+	}
+
+	period := req.Period
+	if period == "" {
+		period = model.QuarterlyPeriod
+	}
+	if period != model.QuarterlyPeriod && period != model.AnnualPeriod {
+		return nil, status.Error(codes.InvalidArgument, "period must be annual or quarterly")
+	}
+
+	end := time.Now()
+	if req.EndDate != nil {
+		if !req.EndDate.IsValid() {
+			return nil, status.Error(codes.InvalidArgument, "invalid end date")
+		}
+		end = req.EndDate.AsTime()
+	}
+	start := end.AddDate(-5, 0, 0)
+	if req.StartDate != nil {
+		if !req.StartDate.IsValid() {
+			return nil, status.Error(codes.InvalidArgument, "invalid start date")
+		}
+		start = req.StartDate.AsTime()
+	}
+	if start.After(end) {
+		return nil, status.Error(codes.InvalidArgument, "start date must be before end date")
+	}
+
+	symbol, err := s.symbolRepository.GetByUuid(ctx, req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, validationErrors.NoSymbolFound)
+	}
+
+	// listings of the same security share its fundamentals
+	if symbol.Isin != "" {
+		primary, err := s.securityRepository.GetPrimaryListing(ctx, symbol.Isin)
+		if err == nil {
+			symbol = primary
+		}
+	}
+	psym := symbol.ToProto()
+
+	series := make(map[string][]*instrument_service.MetricPoint)
+	for source, fields := range bySource {
+		if source == model.OverviewMetrics {
+			snapshots, err := s.fundamentalsRepository.GetSnapshots(ctx, psym.Uuid, psym.Isin, start, end)
+			if err != nil {
+				return nil, err
+			}
+			for _, snapshot := range snapshots {
+				values := snapshot.Metrics()
+				for _, field := range fields {
+					if v, ok := values[field]; ok {
+						metric := source + "." + field
+						series[metric] = append(series[metric], &instrument_service.MetricPoint{
+							Timestamp: timestamppb.New(snapshot.UpdatedAt),
+							Value:     v,
+						})
+					}
+				}
+			}
+			continue
+		}
+
+		reports, err := s.fundamentalsRepository.GetReports(ctx, psym.Uuid, psym.Isin, source, period, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, report := range reports {
+			for _, field := range fields {
+				if v, ok := report.Values[field]; ok {
+					metric := source + "." + field
+					series[metric] = append(series[metric], &instrument_service.MetricPoint{
+						Timestamp: timestamppb.New(report.FiscalDateEnding),
+						Value:     v,
+					})
+				}
+			}
+		}
+	}
+
+	res := &instrument_service.FundamentalsResponse{}
+	for _, metric := range metrics {
+		res.Series = append(res.Series, &instrument_service.MetricSeries{
+			Metric: metric,
+			Points: series[metric],
+		})
+	}
+
+	fetchedAt, err := s.fundamentalsRepository.GetLastFetchedAt(ctx, psym.Uuid, psym.Isin)
+	if err != nil {
+		return nil, err
+	}
+	if !fetchedAt.IsZero() {
+		res.FetchedAt = timestamppb.New(fetchedAt)
+	}
+	if fetchedAt.IsZero() || time.Since(fetchedAt) > reportsMaxAge {
+		s.overviewRefresher.EnqueueReports(psym)
+		res.Refreshing = true
+	}
+
+	return res, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
 
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
 	"github.com/vectorman1/analysis/analysis-api/domain/instrument/repo"
 	"github.com/vectorman1/analysis/analysis-api/domain/instrument/third_party"
 
@@ -21,17 +22,26 @@ import (
 
 type HistoryServiceContract interface {
 	GetSymbolHistory(ctx context.Context, req *instrument_service.HistoryRequest) (*instrument_service.HistoryResponse, error)
-	UpdateSymbolHistory(ctx context.Context, symUuid string, identifier string) (int, error)
+	UpdateSymbolHistory(ctx context.Context, sym *model.Symbol, market *model.Market) (int, error)
 	GetChartBySymbolUuid(ctx context.Context, req *instrument_service.ChartRequest) (*instrument_service.ChartResponse, error)
 	UpdateAll(ctx context.Context) error
 }
 
+// chartPreferences reads the chart range users chose, which is empty if they chose none,
+// and the location of their timezone
+type chartPreferences interface {
+	ChartPreferences(ctx context.Context, userUuid string) (string, *time.Location, error)
+}
+
 type HistoryService struct {
 	yahooService             *third_party.YahooService
 	historyRepository        *repo.HistoryRepository
 	symbolRepository         *repo.SymbolRepository
 	symbolOverviewRepository *repo.SymbolOverviewRepository
 	reportService            *ReportService
+	marketService            *MarketService
+	tickerMappingService     *TickerMappingService
+	chartPreferences         chartPreferences
 }
 
 func NewHistoryService(
@@ -39,13 +49,19 @@ func NewHistoryService(
 	historicalRepository *repo.HistoryRepository,
 	symbolRepository *repo.SymbolRepository,
 	symbolOverviewRepository *repo.SymbolOverviewRepository,
-	reportService *ReportService) *HistoryService {
+	reportService *ReportService,
+	marketService *MarketService,
+	tickerMappingService *TickerMappingService,
+	chartPreferences chartPreferences) *HistoryService {
 	return &HistoryService{
 		yahooService:             yahooService,
 		historyRepository:        historicalRepository,
 		symbolRepository:         symbolRepository,
 		symbolOverviewRepository: symbolOverviewRepository,
 		reportService:            reportService,
+		marketService:            marketService,
+		tickerMappingService:     tickerMappingService,
+		chartPreferences:         chartPreferences,
 	}
 }
 
@@ -68,16 +84,20 @@ func (s *HistoryService) GetSymbolHistory(ctx context.Context, req *instrument_s
 	return &instrument_service.HistoryResponse{Items: response}, nil
 }
 
-func (s *HistoryService) UpdateSymbolHistory(ctx context.Context, symUuid string, identifier string) (int, error) {
+func (s *HistoryService) UpdateSymbolHistory(ctx context.Context, sym *model.Symbol, market *model.Market) (int, error) {
+	var symUuid string
+	sym.Uuid.AssignTo(&symUuid)
+
 	lastHistory, err := s.historyRepository.GetLastSymbolHistory(ctx, symUuid)
 	// handle initial update of symbol
 	if err != nil {
 		beginningOfTime := time.Date(2000, 0, 0, 0, 0, 0, 0, time.UTC)
 
 		// get history from Yahoo
-		histories, err := s.yahooService.GetIdentifierHistory(
-			symUuid,
-			identifier,
+		histories, err := s.getIdentifierHistory(
+			ctx,
+			sym,
+			market,
 			beginningOfTime,
 			time.Now())
 		if err != nil {
@@ -104,9 +124,10 @@ func (s *HistoryService) UpdateSymbolHistory(ctx context.Context, symUuid string
 		}
 
 		// get symbol history from (last + 24h) until now
-		candles, err := s.yahooService.GetIdentifierHistory(
-			symUuid,
-			identifier,
+		candles, err := s.getIdentifierHistory(
+			ctx,
+			sym,
+			market,
 			lastHistory.Timestamp.Add(time.Hour*24),
 			end)
 		if err != nil {
@@ -147,18 +168,81 @@ func (s *HistoryService) UpdateSymbolHistory(ctx context.Context, symUuid string
 	return 0, nil
 }
 
+// getIdentifierHistory gets the history of the symbol from Yahoo using its mapped ticker.
+// If the provider has no data for the ticker, it is looked up by the symbol's ISIN before
+// the mapping is marked as failed. Transient provider errors leave the mapping as it is.
+func (s *HistoryService) getIdentifierHistory(
+	ctx context.Context,
+	sym *model.Symbol,
+	market *model.Market,
+	start time.Time,
+	end time.Time) (*[]model.History, error) {
+	var symUuid string
+	sym.Uuid.AssignTo(&symUuid)
+
+	mapping, err := s.tickerMappingService.Resolve(ctx, sym, market, common.ProviderYahoo)
+	if err != nil {
+		return nil, err
+	}
+
+	histories, err := s.yahooService.GetIdentifierHistory(symUuid, mapping.Ticker, start, end)
+	if err == nil {
+		return histories, s.markResolved(ctx, mapping, histories)
+	}
+	if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	if mapping.Source != common.TickerMappingManual {
+		isinMapping, lookupErr := s.tickerMappingService.LookupByIsin(ctx, sym, market, common.ProviderYahoo)
+		if lookupErr == nil && isinMapping.Ticker != mapping.Ticker {
+			histories, isinErr := s.yahooService.GetIdentifierHistory(symUuid, isinMapping.Ticker, start, end)
+			if isinErr == nil {
+				return histories, s.markResolved(ctx, isinMapping, histories)
+			}
+			if status.Code(isinErr) != codes.NotFound {
+				return nil, isinErr
+			}
+		} else if lookupErr != nil && !isDefinitiveLookupError(lookupErr) {
+			return nil, err
+		}
+	}
+
+	if markErr := s.tickerMappingService.MarkFailed(ctx, mapping, err); markErr != nil {
+		grpclog.Errorf("failed to store ticker mapping failure of %s: %v", symUuid, markErr)
+	}
+
+	return nil, err
+}
+
+// isDefinitiveLookupError reports whether the ISIN lookup failed because the symbol can't be
+// found by it, rather than because the provider couldn't be reached
+func isDefinitiveLookupError(err error) bool {
+	code := status.Code(err)
+	return code == codes.NotFound || code == codes.FailedPrecondition
+}
+
+// markResolved stores the mapping as resolved once the provider returned data for it. An empty
+// history only means there were no new bars in the range, so it doesn't confirm the ticker.
+func (s *HistoryService) markResolved(ctx context.Context, mapping *model.TickerMapping, histories *[]model.History) error {
+	if histories == nil || len(*histories) == 0 {
+		return nil
+	}
+
+	return s.tickerMappingService.MarkResolved(ctx, mapping)
+}
+
+// GetChartBySymbolUuid returns the chart of the requested dates. When no dates are requested
+// the chart ends now and covers the user's default chart range.
 func (s *HistoryService) GetChartBySymbolUuid(
 	ctx context.Context,
 	req *instrument_service.ChartRequest) (*instrument_service.ChartResponse, error) {
-	if !req.StartDate.IsValid() || !req.EndDate.IsValid() {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid date")
+	start, end, err := s.chartDates(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	histories, err := s.historyRepository.GetSymbolHistory(
-		ctx,
-		req.Uuid,
-		req.StartDate.AsTime(),
-		req.EndDate.AsTime(), false)
+	histories, err := s.historyRepository.GetSymbolHistory(ctx, req.Uuid, start, end, false)
 	if err != nil {
 		return nil, err
 	}
@@ -179,65 +263,85 @@ func (s *HistoryService) GetChartBySymbolUuid(
 	return &res, nil
 }
 
+func (s *HistoryService) chartDates(ctx context.Context, req *instrument_service.ChartRequest) (time.Time, time.Time, error) {
+	if req.StartDate != nil || req.EndDate != nil {
+		if !req.StartDate.IsValid() || !req.EndDate.IsValid() {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid date")
+		}
+		return req.StartDate.AsTime(), req.EndDate.AsTime(), nil
+	}
+
+	chartRange := common.DefaultChartRange
+	location := time.UTC
+	if claims, ok := common.ClaimsFromContext(ctx); ok {
+		userRange, userLocation, err := s.chartPreferences.ChartPreferences(ctx, claims.Uuid)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		if userRange != "" {
+			chartRange = userRange
+		}
+		location = userLocation
+	}
+
+	// ranges are computed in the user's timezone, so the year to date starts on their first of January
+	end := time.Now().In(location)
+	start, _ := common.ChartRangeStart(chartRange, end)
+	return start, end, nil
+}
+
 func (s *HistoryService) UpdateAll(ctx context.Context) error {
-	res, _, err := s.symbolRepository.GetPaged(
-		context.Background(),
-		&instrument_service.PagedRequest{
-			Filter: &instrument_service.PagedFilter{
-				PageSize:   100000,
-				PageNumber: 1,
-				Order:      "identifier",
-				Ascending:  true,
-			},
-		})
+	var symbols []model.Symbol
+	err := forEachSymbol(context.Background(), s.symbolRepository, nil, func(sym *model.Symbol) error {
+		symbols = append(symbols, *sym)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	grpclog.Infoln("[HISTORY JOB] Length of symbols to update: ", len(*res))
+	markets, err := s.marketService.HistoryEnabled(ctx)
+	if err != nil {
+		return err
+	}
 
-	hoursApprox := float32(len(*res) / 2000)
+	grpclog.Infoln("[HISTORY JOB] Length of symbols to update: ", len(symbols))
+
+	hoursApprox := float32(len(symbols) / 2000)
 	grpclog.Infof("[HISTORY JOB] Job will take at least: %.2f hours", hoursApprox)
 
 	processAvg := common.RollingAverage(10)
 
-	for i, sym := range *res {
+	for i, sym := range symbols {
 		start := time.Now()
 
-		// only update symbol history for these markets
-		if sym.MarketName == "NASDAQ" ||
-			sym.MarketName == "NYSE" ||
-			sym.MarketName == "OTC Markets" ||
-			sym.MarketName == "NON-ISA OTC Markets" ||
-			sym.MarketName == "NON-ISA NYSE" ||
-			sym.MarketName == "NON-ISA NASDAQ" {
-
-			var u string
-			sym.Uuid.AssignTo(&u)
+		// only update symbol history for markets with history enabled
+		if market, ok := markets[sym.MarketName]; ok {
+			sym := sym
 			ctx, c := context.WithTimeout(ctx, 10*time.Second)
-			entries, err := s.UpdateSymbolHistory(ctx, u, sym.Identifier)
+			entries, err := s.UpdateSymbolHistory(ctx, &sym, market)
 			c()
 			if err != nil {
 				grpclog.Errorf("[HISTORY JOB] (%d/%d) Failed to update histories at: %s %s %s %s err: %v",
-					i+1, len(*res),
+					i+1, len(symbols),
 					sym.Isin, sym.Identifier, sym.Name, sym.MarketName, err)
 				continue
 			} else if entries == 0 {
 				grpclog.Infof("[HISTORY JOB] (%d/%d) No need to update: %s %s %s %s ",
-					i+1, len(*res),
+					i+1, len(symbols),
 					sym.Isin, sym.Identifier, sym.Name, sym.MarketName)
 				continue
 			}
 
 			grpclog.Infof("[HISTORY JOB] (%d/%d) Updated: %s %s %s %s Added entries: %d",
-				i+1, len(*res),
+				i+1, len(symbols),
 				sym.Isin, sym.Identifier, sym.Name, sym.MarketName, entries)
 
 			// timeout to avoid throttle
 			time.Sleep(2 * time.Second)
 		} else {
 			grpclog.Infof("[HISTORY JOB] (%d/%d) Skipping: %s %s %s %s",
-				i+1, len(*res),
+				i+1, len(symbols),
 				sym.Isin, sym.Identifier, sym.Name, sym.MarketName)
 		}
 
@@ -246,7 +350,7 @@ func (s *HistoryService) UpdateAll(ctx context.Context) error {
 
 		if i%25 == 0 {
 			grpclog.Infof("[HISTORY JOB] MA of last 10 processed histories (per item): %2f seconds", newAvg)
-			itemsLeft := len(*res) - i
+			itemsLeft := len(symbols) - i
 			approxSecondsLeft := newAvg * float64(itemsLeft)
 			grpclog.Infof("[HISTORY JOB] Estimated time left: %2f hours, or %2f minutes, or %2f seconds",
 				approxSecondsLeft/60/60, approxSecondsLeft/60, approxSecondsLeft)
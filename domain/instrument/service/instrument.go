@@ -17,7 +17,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
 )
@@ -25,8 +28,19 @@ import (
 type InstrumentsServiceContract interface {
 	// repo methods
 	Get(ctx context.Context, uuid string) (*instrument_service.Instrument, error)
-	GetPaged(ctx context.Context, req *instrument_service.PagedRequest) (*[]*instrument_service.Instrument, uint, error)
+	GetPaged(ctx context.Context, req *instrument_service.PagedRequest) (*[]*instrument_service.Instrument, uint, string, error)
+	ListAll(ctx context.Context, req *instrument_service.ListAllRequest, send func(*instrument_service.Instrument) error) error
 	Overview(ctx context.Context, req *instrument_service.InstrumentRequest) (*instrument_service.InstrumentOverview, error)
+	Revisions(ctx context.Context, req *instrument_service.InstrumentRequest) (*instrument_service.RevisionsResponse, error)
+	Listings(ctx context.Context, req *instrument_service.InstrumentRequest) (*instrument_service.ListingsResponse, error)
+	Fundamentals(ctx context.Context, req *instrument_service.FundamentalsRequest) (*instrument_service.FundamentalsResponse, error)
+	ScreenFundamentals(ctx context.Context, req *instrument_service.ScreenFundamentalsRequest) (*instrument_service.ScreenFundamentalsResponse, error)
+	ProviderQuotas(ctx context.Context, req *instrument_service.ProviderQuotasRequest) (*instrument_service.ProviderQuotasResponse, error)
+	Peers(ctx context.Context, req *instrument_service.PeersRequest) (*instrument_service.PeersResponse, error)
+	SetPrimaryListing(ctx context.Context, req *instrument_service.SetPrimaryListingRequest) (*instrument_service.Security, error)
+	Search(ctx context.Context, req *instrument_service.SearchRequest) (*instrument_service.SearchResponse, error)
+	BatchGet(ctx context.Context, req *instrument_service.BatchGetRequest) (*instrument_service.BatchGetResponse, error)
+	UploadInstruments(ctx context.Context, req *instrument_service.UploadInstrumentsRequest) (*instrument_service.UploadInstrumentsResponse, error)
 
 	// service methods
 	UpdateAll(ctx context.Context) (*instrument_service.UpdateAllResponse, error)
@@ -34,26 +48,41 @@ type InstrumentsServiceContract interface {
 		input []*instrument_service.InstrumentStatus,
 		ctx context.Context) (*instrument_service.UpdateAllResponse, error)
 	symbolDataToEntity(in *[]*instrument_service.Instrument) ([]*model.Symbol, error)
-	filterUnusableSymbols(symbols *[]*instrument_service.Instruments) *[]*instrument_service.Instrument
+	filterUnusableSymbols(ctx context.Context, symbols *[]*instrument_service.Instrument) (*[]*instrument_service.Instrument, error)
 }
 
 type InstrumentsService struct {
-	symbolRepository         *repo.SymbolRepository
-	symbolOverviewRepository *repo.SymbolOverviewRepository
-	alphaVantageService      *third_party.AlphaVantageService
-	externalSymbolService    *third_party.ExternalSymbolService
+	symbolRepository           *repo.SymbolRepository
+	symbolOverviewRepository   *repo.SymbolOverviewRepository
+	securityRepository         *repo.SecurityRepository
+	fundamentalsRepository     *repo.FundamentalsRepository
+	historyRepository          *repo.HistoryRepository
+	instrumentUploadRepository *repo.InstrumentUploadRepository
+	overviewRefresher          *OverviewRefresher
+	instrumentSources          []third_party.InstrumentSource
+	marketService              *MarketService
 }
 
 func NewSymbolService(
 	symbolsRepository *repo.SymbolRepository,
 	symbolOverviewRepository *repo.SymbolOverviewRepository,
-	alphaVantageService *third_party.AlphaVantageService,
-	externalSymbolService *third_party.ExternalSymbolService) *InstrumentsService {
+	securityRepository *repo.SecurityRepository,
+	fundamentalsRepository *repo.FundamentalsRepository,
+	historyRepository *repo.HistoryRepository,
+	instrumentUploadRepository *repo.InstrumentUploadRepository,
+	overviewRefresher *OverviewRefresher,
+	instrumentSources []third_party.InstrumentSource,
+	marketService *MarketService) *InstrumentsService {
 	return &InstrumentsService{
-		symbolRepository:         symbolsRepository,
-		symbolOverviewRepository: symbolOverviewRepository,
-		alphaVantageService:      alphaVantageService,
-		externalSymbolService:    externalSymbolService,
+		symbolRepository:           symbolsRepository,
+		symbolOverviewRepository:   symbolOverviewRepository,
+		securityRepository:         securityRepository,
+		fundamentalsRepository:     fundamentalsRepository,
+		historyRepository:          historyRepository,
+		instrumentUploadRepository: instrumentUploadRepository,
+		overviewRefresher:          overviewRefresher,
+		instrumentSources:          instrumentSources,
+		marketService:              marketService,
 	}
 }
 
@@ -66,96 +95,429 @@ func (s *InstrumentsService) Get(ctx context.Context, uuid string) (*instrument_
 	return sym.ToProto(), nil
 }
 
+const listAllPageSize = 1000
+const overviewFetchTimeout = 15 * time.Second
+const maxBatchGetKeys = 500
+
+// instrumentQueryFields are the fields instruments can be sorted and filtered by. The real
+// minimumOrderQuantity is read as float8, the type its page token cursor value is parsed as.
+var instrumentQueryFields = common.QueryFields{
+	"identifier":           {Column: "identifier", Type: common.TextField},
+	"name":                 {Column: "name", Type: common.TextField},
+	"isin":                 {Column: "isin", Type: common.TextField},
+	"currencyCode":         {Column: "currencyCode", Type: common.TextField},
+	"marketName":           {Column: "marketName", Type: common.TextField},
+	"source":               {Column: "source", Type: common.TextField},
+	"minimumOrderQuantity": {Column: "minimumOrderQuantity::float8", Type: common.NumberField},
+	"createdAt":            {Column: "createdAt", Type: common.TimeField},
+	"updatedAt":            {Column: "updatedAt", Type: common.TimeField},
+}
+
+// instrumentPagedQuery validates the paged filter against the instrument fields
+func instrumentPagedQuery(filter *instrument_service.PagedFilter) (*common.PagedQuery, error) {
+	return instrumentQueryFields.Compile(common.PagedSpecFromProto(filter))
+}
+
+func fieldFilters(in []*instrument_service.FieldFilter) []common.FieldFilter {
+	var filters []common.FieldFilter
+	for _, f := range in {
+		filters = append(filters, common.FieldFilterFromProto(f))
+	}
+
+	return filters
+}
+
+// forEachSymbol calls fn with every symbol matching the filters, reading them in pages of listAllPageSize
+func forEachSymbol(
+	ctx context.Context,
+	symbolRepository *repo.SymbolRepository,
+	filters []*instrument_service.FieldFilter,
+	fn func(sym *model.Symbol) error) error {
+	pageToken := ""
+	for {
+		pagedQuery, err := instrumentPagedQuery(&instrument_service.PagedFilter{
+			PageSize:  listAllPageSize,
+			PageToken: pageToken,
+			Filters:   filters,
+		})
+		if err != nil {
+			return err
+		}
+
+		syms, _, next, err := symbolRepository.GetPaged(ctx, pagedQuery)
+		if err != nil {
+			return err
+		}
+		for i := range *syms {
+			if err = fn(&(*syms)[i]); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		pageToken = next
+	}
+}
+
 func (s *InstrumentsService) GetPaged(
 	ctx context.Context,
-	req *instrument_service.PagedRequest) (*[]*instrument_service.Instrument, uint, error) {
+	req *instrument_service.PagedRequest) (*[]*instrument_service.Instrument, uint, string, error) {
 	if req.Filter == nil {
-		return nil, 0, status.Errorf(codes.InvalidArgument, "provide filter")
+		return nil, 0, "", status.Errorf(codes.InvalidArgument, "provide filter")
+	}
+	if req.Filter.Order == "" && len(req.Filter.Sort) == 0 {
+		return nil, 0, "", status.Error(codes.InvalidArgument, "provide order argument")
 	}
-	if req.Filter.Order == "" {
-		return nil, 0, status.Error(codes.InvalidArgument, "provide order argument")
+	pagedQuery, err := instrumentPagedQuery(req.Filter)
+	if err != nil {
+		return nil, 0, "", err
 	}
 
 	var res []*instrument_service.Instrument
-	syms, totalItemsCount, err := s.symbolRepository.GetPaged(ctx, req)
+	syms, totalItemsCount, nextPageToken, err := s.symbolRepository.GetPaged(ctx, pagedQuery)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	for _, sym := range *syms {
 		res = append(res, sym.ToProto())
 	}
 
-	return &res, totalItemsCount, nil
+	return &res, totalItemsCount, nextPageToken, nil
+}
+
+// ListAll sends every instrument matching the filters, ordered by the time they were stored
+func (s *InstrumentsService) ListAll(
+	ctx context.Context,
+	req *instrument_service.ListAllRequest,
+	send func(*instrument_service.Instrument) error) error {
+	return forEachSymbol(ctx, s.symbolRepository, req.Filters, func(sym *model.Symbol) error {
+		return send(sym.ToProto())
+	})
 }
 
 func (s *InstrumentsService) Overview(
 	ctx context.Context,
 	req *instrument_service.InstrumentRequest) (*instrument_service.InstrumentOverview, error) {
-	userInfo := ctx.Value("user_info")
-	if userInfo == nil {
-		return nil, status.Error(codes.Unauthenticated, "provide user token")
-	}
-
 	symbol, err := s.symbolRepository.GetByUuid(ctx, req.Uuid)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, validationErrors.NoSymbolFound)
 	}
+
+	// listings of the same security share the overview of its primary listing
+	if symbol.Isin != "" {
+		primary, err := s.securityRepository.GetPrimaryListing(ctx, symbol.Isin)
+		if err == nil {
+			symbol = primary
+		}
+	}
 	psym := symbol.ToProto()
 
-	overview, err := s.symbolOverviewRepository.GetByInstrumentUuid(ctx, req.Uuid)
-	if err != nil {
-		newOverview, err := s.getAndInsertInstrumentOverview(ctx, psym)
+	// the stored overview is served even when stale, while it is refreshed in the background
+	overview, err := s.getStoredOverview(ctx, psym)
+	if err == mongo.ErrNoDocuments {
+		// the request doesn't wait for the next minute when the Alpha Vantage quota is used up
+		refreshCtx, cancel := context.WithTimeout(ctx, overviewFetchTimeout)
+		overview, err = s.overviewRefresher.Refresh(refreshCtx, psym)
+		cancel()
 		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return nil, status.Error(codes.Unavailable, "overview is not available yet, try again later")
+			}
 			return nil, status.Error(codes.NotFound, validationErrors.NoOverviewFoundForSymbol)
 		}
-
-		overview = newOverview
+	} else if err != nil {
+		return nil, err
 	} else if overview.ShouldUpdate() {
-		err := s.symbolOverviewRepository.Delete(ctx, psym.Uuid)
-		if err != nil {
-			return nil, err
+		s.overviewRefresher.Enqueue(psym)
+	}
+
+	return overview.ToProto(), nil
+}
+
+// ProviderQuotas returns the usage of the quotas of the data providers
+func (s *InstrumentsService) ProviderQuotas(
+	ctx context.Context,
+	req *instrument_service.ProviderQuotasRequest) (*instrument_service.ProviderQuotasResponse, error) {
+	usage, err := s.overviewRefresher.QuotaUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &instrument_service.ProviderQuotasResponse{}
+	for i := range usage {
+		res.Items = append(res.Items, usage[i].ToProto())
+	}
+
+	return res, nil
+}
+
+// BatchGet resolves a mix of uuid, ISIN and identifier with market name keys in a single
+// query. Keys which match no instrument are returned as unresolved.
+func (s *InstrumentsService) BatchGet(
+	ctx context.Context,
+	req *instrument_service.BatchGetRequest) (*instrument_service.BatchGetResponse, error) {
+	if len(req.Keys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "provide keys")
+	}
+	if len(req.Keys) > maxBatchGetKeys {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d keys can be requested", maxBatchGetKeys)
+	}
+
+	var keys []model.InstrumentKey
+	for i, k := range req.Keys {
+		kinds := 0
+		if k.Uuid != "" {
+			if _, err := uuid.FromString(k.Uuid); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "key %d: invalid uuid", i)
+			}
+			kinds++
+		}
+		if k.Isin != "" {
+			kinds++
+		}
+		if k.Identifier != "" || k.MarketName != "" {
+			if k.Identifier == "" || k.MarketName == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "key %d: identifier requires a market name", i)
+			}
+			kinds++
+		}
+		if kinds != 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "key %d: provide one of uuid, isin or identifier and market name", i)
 		}
 
-		newOverview, err := s.getAndInsertInstrumentOverview(ctx, psym)
-		if err != nil {
-			return nil, status.Error(codes.NotFound, validationErrors.NoOverviewFoundForSymbol)
+		keys = append(keys, model.InstrumentKey{}.FromProtoObject(k))
+	}
+
+	found, err := s.symbolRepository.GetByKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &instrument_service.BatchGetResponse{}
+	for i, k := range req.Keys {
+		syms, ok := found[i]
+		if !ok {
+			res.Unresolved = append(res.Unresolved, k)
+			continue
 		}
 
-		overview = newOverview
+		item := &instrument_service.BatchGetResult{Key: k}
+		for _, sym := range syms {
+			item.Instruments = append(item.Instruments, sym.ToProto())
+		}
+		res.Items = append(res.Items, item)
 	}
 
-	return overview.ToProto(), nil
+	return res, nil
+}
+
+// Revisions returns the timeline of changes recorded for an instrument
+func (s *InstrumentsService) Revisions(
+	ctx context.Context,
+	req *instrument_service.InstrumentRequest) (*instrument_service.RevisionsResponse, error) {
+	_, err := s.symbolRepository.GetByUuid(ctx, req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, validationErrors.NoSymbolFound)
+	}
+
+	revisions, err := s.symbolRepository.GetRevisions(ctx, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*instrument_service.InstrumentRevision
+	for _, rev := range *revisions {
+		res = append(res, rev.ToProto())
+	}
+
+	return &instrument_service.RevisionsResponse{Items: res}, nil
+}
+
+// Listings returns the security of an instrument along with all of its listings
+func (s *InstrumentsService) Listings(
+	ctx context.Context,
+	req *instrument_service.InstrumentRequest) (*instrument_service.ListingsResponse, error) {
+	sym, err := s.symbolRepository.GetByUuid(ctx, req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, validationErrors.NoSymbolFound)
+	}
+	if sym.Isin == "" {
+		return &instrument_service.ListingsResponse{Items: []*instrument_service.Instrument{sym.ToProto()}}, nil
+	}
+
+	security, err := s.getSecurity(ctx, sym.Isin)
+	if err != nil {
+		return nil, err
+	}
+
+	listings, err := s.securityRepository.GetListings(ctx, sym.Isin)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*instrument_service.Instrument
+	for _, listing := range *listings {
+		res = append(res, listing.ToProto())
+	}
+
+	return &instrument_service.ListingsResponse{
+		Security: security,
+		Items:    res,
+	}, nil
+}
+
+// SetPrimaryListing picks the listing of a security which its shared data is fetched for
+func (s *InstrumentsService) SetPrimaryListing(
+	ctx context.Context,
+	req *instrument_service.SetPrimaryListingRequest) (*instrument_service.Security, error) {
+	sym, err := s.symbolRepository.GetByUuid(ctx, req.InstrumentUuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, validationErrors.NoSymbolFound)
+	}
+	if sym.Isin != req.Isin {
+		return nil, status.Error(codes.InvalidArgument, "instrument is not a listing of the security")
+	}
+	if sym.DeletedAt.Status == pgtype.Present {
+		return nil, status.Error(codes.FailedPrecondition, "instrument is deleted")
+	}
+
+	ok, err := s.securityRepository.SetPrimaryListing(ctx, req.Isin, req.InstrumentUuid)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, validationErrors.NoSecurityFound)
+	}
+
+	return s.getSecurity(ctx, req.Isin)
+}
+
+// getSecurity returns the security with the given ISIN and its effective primary listing
+func (s *InstrumentsService) getSecurity(ctx context.Context, isin string) (*instrument_service.Security, error) {
+	security, err := s.securityRepository.GetByIsin(ctx, isin)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, validationErrors.NoSecurityFound)
+		}
+		return nil, err
+	}
+
+	res := security.ToProto()
+	primary, err := s.securityRepository.GetPrimaryListing(ctx, isin)
+	if err == nil {
+		var u string
+		primary.Uuid.AssignTo(&u)
+		res.PrimaryListingUuid = u
+	} else if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UploadInstruments validates and stores a CSV of instruments, which
+// is used as the manual instrument source on the next sync
+func (s *InstrumentsService) UploadInstruments(
+	ctx context.Context,
+	req *instrument_service.UploadInstrumentsRequest) (*instrument_service.UploadInstrumentsResponse, error) {
+	instruments, err := third_party.ParseManualInstruments(req.Csv)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid csv: %v", err)
+	}
+	for i, sym := range *instruments {
+		if sym.Name == "" || sym.MarketName == "" || sym.CurrencyCode == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "row %d: name, market_name and currency_code are required", i+1)
+		}
+	}
+
+	err = s.instrumentUploadRepository.Insert(ctx, req.Csv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instrument_service.UploadInstrumentsResponse{Items: int64(len(*instruments))}, nil
 }
 
 func (s *InstrumentsService) UpdateAll(ctx context.Context) (*instrument_service.UpdateAllResponse, error) {
-	oldSymbols, _, err := s.GetPaged(ctx, &instrument_service.PagedRequest{
-		Filter: &instrument_service.PagedFilter{
-			PageSize:   100000,
-			PageNumber: 1,
-			Order:      "identifier",
-			Ascending:  true,
-		},
+	var oldSymbols []*instrument_service.Instrument
+	err := forEachSymbol(ctx, s.symbolRepository, nil, func(sym *model.Symbol) error {
+		oldSymbols = append(oldSymbols, sym.ToProto())
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	externalSymbols, err := s.externalSymbolService.GetLatest(ctx)
+	externalSymbols, failedSources, err := s.getLatestFromSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedSymbols, err := s.symbolRepository.GetDeleted(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedProtoSymbols []*instrument_service.Instrument
+	for _, sym := range *deletedSymbols {
+		deletedProtoSymbols = append(deletedProtoSymbols, sym.ToProto())
+	}
+
+	externalSymbols, syncedMarkets, err := s.filterUnusableSymbols(ctx, externalSymbols)
 	if err != nil {
 		return nil, err
 	}
+	keepStoredUuids(*externalSymbols, deletedProtoSymbols, oldSymbols)
+	result := s.generateRecalculationResult(*externalSymbols, oldSymbols, deletedProtoSymbols)
 
-	externalSymbols = s.filterUnusableSymbols(externalSymbols)
-	result := s.generateRecalculationResult(*externalSymbols, *oldSymbols)
+	// keep the instruments of sources which failed to respond, and of markets which weren't synced,
+	// as they are missing from the latest instruments without having been delisted
+	for _, res := range result {
+		if res.Type != instrument_service.InstrumentStatus_DELETE {
+			continue
+		}
+		if _, synced := syncedMarkets[res.Symbol.MarketName]; failedSources[res.Symbol.Source] || !synced {
+			res.Type = instrument_service.InstrumentStatus_IGNORE
+		}
+	}
 	response, err := s.recalculateRelevantInstruments(result, ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	// listings may have gained an ISIN, which their overviews are keyed by from now on
+	if err = s.BackfillOverviewIsins(ctx); err != nil {
+		grpclog.Errorf("failed to key overviews by isin: %v", err)
+	}
+
 	return response, nil
 }
 
+// BackfillOverviewIsins keys the overviews stored by symbol uuid by the ISIN of the symbol,
+// so they are shared by the listings of its security and found by ISIN
+func (s *InstrumentsService) BackfillOverviewIsins(ctx context.Context) error {
+	uuids, err := s.symbolOverviewRepository.GetUuidsWithoutIsin(ctx)
+	if err != nil || len(uuids) == 0 {
+		return err
+	}
+
+	isins, err := s.symbolRepository.GetIsins(ctx, uuids)
+	if err != nil {
+		return err
+	}
+
+	for u, isin := range isins {
+		if err = s.symbolOverviewRepository.SetIsin(ctx, u, isin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *InstrumentsService) recalculateRelevantInstruments(
 	input []*instrument_service.InstrumentStatus,
 	ctx context.Context) (*instrument_service.UpdateAllResponse, error) {
@@ -163,6 +525,7 @@ func (s *InstrumentsService) recalculateRelevantInstruments(
 	var createSymbols []*instrument_service.Instrument
 	var updateSymbols []*instrument_service.Instrument
 	var deleteSymbols []*instrument_service.Instrument
+	var restoreSymbols []*instrument_service.Instrument
 
 	itemsIgnored := int64(0)
 	for _, res := range input {
@@ -173,6 +536,8 @@ func (s *InstrumentsService) recalculateRelevantInstruments(
 			updateSymbols = append(updateSymbols, res.Symbol)
 		case instrument_service.InstrumentStatus_DELETE:
 			deleteSymbols = append(deleteSymbols, res.Symbol)
+		case instrument_service.InstrumentStatus_RESTORE:
+			restoreSymbols = append(restoreSymbols, res.Symbol)
 		case instrument_service.InstrumentStatus_IGNORE:
 			itemsIgnored++
 		}
@@ -223,17 +588,37 @@ func (s *InstrumentsService) recalculateRelevantInstruments(
 		return nil, err
 	}
 
+	// restore previously deleted entities
+	restoreEntities, err := s.symbolDataToEntity(&restoreSymbols)
+	if err != nil {
+		tx.RollbackEx(timeoutContext)
+		return nil, err
+	}
+	_, err = s.symbolRepository.RestoreBulk(tx, timeoutContext, restoreEntities)
+	if err != nil {
+		tx.RollbackEx(timeoutContext)
+		return nil, err
+	}
+
+	// group new and restored listings into their securities
+	err = s.securityRepository.InsertBulk(tx, timeoutContext, append(createEntities, restoreEntities...))
+	if err != nil {
+		tx.RollbackEx(timeoutContext)
+		return nil, err
+	}
+
 	err = tx.CommitEx(timeoutContext)
 	if err != nil {
 		return nil, err
 	}
 
 	return &instrument_service.UpdateAllResponse{
-		ItemsCreated: int64(len(createSymbols)),
-		ItemsUpdated: int64(len(updateSymbols)),
-		ItemsDeleted: int64(len(deleteSymbols)),
-		ItemsIgnored: itemsIgnored,
-		TotalItems:   int64(len(input)),
+		ItemsCreated:  int64(len(createSymbols)),
+		ItemsUpdated:  int64(len(updateSymbols)),
+		ItemsDeleted:  int64(len(deleteSymbols)),
+		ItemsIgnored:  itemsIgnored,
+		ItemsRestored: int64(len(restoreSymbols)),
+		TotalItems:    int64(len(input)),
 	}, nil
 }
 
@@ -249,7 +634,8 @@ func (s *InstrumentsService) symbolDataToEntity(in *[]*instrument_service.Instru
 
 func (s *InstrumentsService) generateRecalculationResult(
 	newSymbols []*instrument_service.Instrument,
-	oldSymbols []*instrument_service.Instrument) []*instrument_service.InstrumentStatus {
+	oldSymbols []*instrument_service.Instrument,
+	deletedSymbols []*instrument_service.Instrument) []*instrument_service.InstrumentStatus {
 	var result []*instrument_service.InstrumentStatus
 	unique := make(map[string]*instrument_service.InstrumentStatus)
 	output := make(chan *instrument_service.InstrumentStatus)
@@ -257,6 +643,15 @@ func (s *InstrumentsService) generateRecalculationResult(
 	go func() {
 		for _, newSym := range newSymbols {
 			if ok, oldSym := common.ContainsSymbol(newSym.Uuid, oldSymbols); !ok {
+				// symbols which reappear keep their uuid, so they have to be restored instead of created
+				if deleted, _ := common.ContainsSymbol(newSym.Uuid, deletedSymbols); deleted {
+					output <- &instrument_service.InstrumentStatus{
+						Type:   instrument_service.InstrumentStatus_RESTORE,
+						Symbol: newSym,
+					}
+					continue
+				}
+
 				output <- &instrument_service.InstrumentStatus{
 					Type:   instrument_service.InstrumentStatus_CREATE,
 					Symbol: newSym,
@@ -268,8 +663,13 @@ func (s *InstrumentsService) generateRecalculationResult(
 					shouldUpdate = true
 				} else if oldSym.MarketHoursGmt != newSym.MarketHoursGmt {
 					shouldUpdate = true
+				} else if oldSym.Source != newSym.Source {
+					shouldUpdate = true
+				} else if oldSym.Isin != newSym.Isin {
+					// the ISIN of a symbol can appear after it was created, which keeps its uuid
+					shouldUpdate = true
 				}
-				// Identifier, ISIN and Market Name are not checked, as they are used in the uuids of the symbols
+				// Identifier and Market Name are not checked, as they identify the listing of the symbol
 				// if any fields are updated, send an update response
 				if shouldUpdate {
 					output <- &instrument_service.InstrumentStatus{
@@ -317,42 +717,30 @@ func (s *InstrumentsService) generateRecalculationResult(
 	return result
 }
 
-func (s *InstrumentsService) filterUnusableSymbols(symbols *[]*instrument_service.Instrument) *[]*instrument_service.Instrument {
-	var res []*instrument_service.Instrument
+// filterUnusableSymbols keeps only the symbols of markets which are enabled for sync, and returns those markets
+func (s *InstrumentsService) filterUnusableSymbols(
+	ctx context.Context,
+	symbols *[]*instrument_service.Instrument) (*[]*instrument_service.Instrument, map[string]*model.Market, error) {
+	markets, err := s.marketService.SyncEnabled(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
 
+	var res []*instrument_service.Instrument
 	for _, sym := range *symbols {
-		switch sym.MarketName {
-		case common.MarketNASDAQ:
-			res = append(res, sym)
-		case common.MarketNYSE:
-			res = append(res, sym)
-		case common.MarketNonISANYSE:
+		if _, ok := markets[sym.MarketName]; ok {
 			res = append(res, sym)
-		case common.MarketNonISAOTCMarkets:
-			res = append(res, sym)
-		default:
-			continue
 		}
 	}
 
-	return &res
+	return &res, markets, nil
 }
 
-func (s *InstrumentsService) getAndInsertInstrumentOverview(ctx context.Context, sym *instrument_service.Instrument) (*model.InstrumentOverview, error) {
-	extOverview, err := s.alphaVantageService.GetInstrumentOverview(sym.Identifier)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = s.symbolOverviewRepository.Insert(ctx, extOverview.ToEntity(sym.Uuid))
-	if err != nil {
-		return nil, err
-	}
-
-	newOverview, err := s.symbolOverviewRepository.GetByInstrumentUuid(ctx, sym.Uuid)
-	if err != nil {
-		return nil, err
+// getStoredOverview returns the overview of the symbol's security, or of the symbol itself if it has no ISIN
+func (s *InstrumentsService) getStoredOverview(ctx context.Context, sym *instrument_service.Instrument) (*model.InstrumentOverview, error) {
+	if sym.Isin != "" {
+		return s.symbolOverviewRepository.GetByIsin(ctx, sym.Isin)
 	}
 
-	return newOverview, nil
+	return s.symbolOverviewRepository.GetByInstrumentUuid(ctx, sym.Uuid)
 }
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/protobuf/proto"
+)
+
+// getLatestFromSources fetches the instruments of all sources and merges them.
+// Sources which fail are skipped and returned by name, so that their instruments
+// are not deleted because of a temporary outage. It fails only if all sources fail.
+func (s *InstrumentsService) getLatestFromSources(ctx context.Context) (*[]*instrument_service.Instrument, map[string]bool, error) {
+	failedSources := make(map[string]bool)
+	var results [][]*instrument_service.Instrument
+
+	for _, source := range s.instrumentSources {
+		res, err := source.GetLatest(ctx)
+		if err != nil {
+			grpclog.Errorf("failed to get instruments from source %s: %v", source.Name(), err)
+			failedSources[source.Name()] = true
+			continue
+		}
+		results = append(results, *res)
+	}
+
+	if len(results) == 0 {
+		return nil, nil, fmt.Errorf("failed to get instruments from all %d sources", len(s.instrumentSources))
+	}
+
+	merged, err := mergeSourceInstruments(results)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return merged, failedSources, nil
+}
+
+// mergeSourceInstruments merges the instruments of the sources, given in order of priority.
+// Instruments are matched by ISIN, identifier and market name, or only by identifier and market name
+// if one of them has no ISIN, as not all sources provide one. When sources disagree the values of the
+// higher priority source are kept, and values missing from it are filled in from the lower priority ones.
+// The instrument is tagged with the highest priority source it was found in.
+func mergeSourceInstruments(results [][]*instrument_service.Instrument) (*[]*instrument_service.Instrument, error) {
+	var merged []*instrument_service.Instrument
+	byIsin := make(map[string]*instrument_service.Instrument)
+	byListing := make(map[string]*instrument_service.Instrument)
+
+	for _, instruments := range results {
+		for _, sym := range instruments {
+			listingKey := strings.ToUpper(fmt.Sprintf("%s,%s", sym.Identifier, sym.MarketName))
+			isinKey := strings.ToUpper(fmt.Sprintf("%s,%s", sym.Isin, listingKey))
+
+			existing := byIsin[isinKey]
+			if existing == nil && sym.Isin != "" {
+				if e := byListing[listingKey]; e != nil && e.Isin == "" {
+					existing = e
+				}
+			}
+			if existing == nil && sym.Isin == "" {
+				existing = byListing[listingKey]
+			}
+
+			if existing == nil {
+				res := proto.Clone(sym).(*instrument_service.Instrument)
+				merged = append(merged, res)
+				byIsin[isinKey] = res
+				if byListing[listingKey] == nil {
+					byListing[listingKey] = res
+				}
+				continue
+			}
+
+			fillMissingValues(existing, sym)
+			if sym.Isin != "" {
+				byIsin[strings.ToUpper(fmt.Sprintf("%s,%s", existing.Isin, listingKey))] = existing
+			}
+		}
+	}
+
+	for _, sym := range merged {
+		u, err := common.InstrumentUuid(sym.Isin, sym.Identifier, sym.MarketName)
+		if err != nil {
+			return nil, err
+		}
+		sym.Uuid = u
+	}
+
+	return &merged, nil
+}
+
+// keepStoredUuids gives the instruments the uuids of the stored symbols of the same listing. The uuid
+// derived from the ISIN changes once a source starts or stops providing the ISIN of an instrument,
+// which would otherwise delete the symbol and create a new one without its history and mappings.
+// Stored symbols which aren't deleted take precedence over deleted ones.
+func keepStoredUuids(instruments []*instrument_service.Instrument, deleted []*instrument_service.Instrument, stored []*instrument_service.Instrument) {
+	byListing := make(map[string]*instrument_service.Instrument)
+	for _, symbols := range [][]*instrument_service.Instrument{deleted, stored} {
+		for _, sym := range symbols {
+			byListing[strings.ToUpper(fmt.Sprintf("%s,%s", sym.Identifier, sym.MarketName))] = sym
+		}
+	}
+
+	for _, sym := range instruments {
+		existing := byListing[strings.ToUpper(fmt.Sprintf("%s,%s", sym.Identifier, sym.MarketName))]
+		if existing == nil || (existing.Isin != "" && sym.Isin != "" && existing.Isin != sym.Isin) {
+			continue
+		}
+
+		sym.Uuid = existing.Uuid
+		if sym.Isin == "" {
+			sym.Isin = existing.Isin
+		}
+	}
+}
+
+// fillMissingValues sets the empty values of dst from src
+func fillMissingValues(dst *instrument_service.Instrument, src *instrument_service.Instrument) {
+	if dst.Isin == "" {
+		dst.Isin = src.Isin
+	}
+	if dst.Name == "" {
+		dst.Name = src.Name
+	}
+	if dst.CurrencyCode == "" {
+		dst.CurrencyCode = src.CurrencyCode
+	}
+	if dst.MarketHoursGmt == "" {
+		dst.MarketHoursGmt = src.MarketHoursGmt
+	}
+	if dst.MinimumOrderQuantity == 0 {
+		dst.MinimumOrderQuantity = src.MinimumOrderQuantity
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx"
+
+	validationErrors "github.com/vectorman1/analysis/analysis-api/common/errors"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type MarketServiceContract interface {
+	List(ctx context.Context) (*instrument_service.ListMarketsResponse, error)
+	Create(ctx context.Context, req *instrument_service.Market) (*instrument_service.Market, error)
+	Update(ctx context.Context, req *instrument_service.Market) (*instrument_service.Market, error)
+	Delete(ctx context.Context, req *instrument_service.MarketRequest) (*instrument_service.DeleteMarketResponse, error)
+	SyncEnabled(ctx context.Context) (map[string]*model.Market, error)
+	HistoryEnabled(ctx context.Context) (map[string]*model.Market, error)
+}
+
+type MarketService struct {
+	marketRepository *repo.MarketRepository
+}
+
+func NewMarketService(marketRepository *repo.MarketRepository) *MarketService {
+	return &MarketService{
+		marketRepository: marketRepository,
+	}
+}
+
+func (s *MarketService) List(ctx context.Context) (*instrument_service.ListMarketsResponse, error) {
+	markets, err := s.marketRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*instrument_service.Market
+	for _, m := range *markets {
+		res = append(res, m.ToProto())
+	}
+
+	return &instrument_service.ListMarketsResponse{Items: res}, nil
+}
+
+func (s *MarketService) Create(ctx context.Context, req *instrument_service.Market) (*instrument_service.Market, error) {
+	if err := validateMarket(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.marketRepository.GetByName(ctx, req.Name); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "market already exists")
+	}
+
+	err := s.marketRepository.Create(ctx, model.Market{}.FromProtoObject(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.get(ctx, req.Name)
+}
+
+func (s *MarketService) Update(ctx context.Context, req *instrument_service.Market) (*instrument_service.Market, error) {
+	if err := validateMarket(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.get(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	err := s.marketRepository.Update(ctx, model.Market{}.FromProtoObject(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.get(ctx, req.Name)
+}
+
+func (s *MarketService) Delete(ctx context.Context, req *instrument_service.MarketRequest) (*instrument_service.DeleteMarketResponse, error) {
+	ok, err := s.marketRepository.Delete(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, validationErrors.NoMarketFound)
+	}
+
+	return &instrument_service.DeleteMarketResponse{}, nil
+}
+
+// SyncEnabled returns the markets whose instruments are synced from the sources, by name
+func (s *MarketService) SyncEnabled(ctx context.Context) (map[string]*model.Market, error) {
+	return s.filter(ctx, func(m *model.Market) bool { return m.SyncEnabled })
+}
+
+// HistoryEnabled returns the markets whose instruments have their history updated, by name
+func (s *MarketService) HistoryEnabled(ctx context.Context) (map[string]*model.Market, error) {
+	return s.filter(ctx, func(m *model.Market) bool { return m.HistoryEnabled })
+}
+
+func (s *MarketService) filter(ctx context.Context, predicate func(m *model.Market) bool) (map[string]*model.Market, error) {
+	markets, err := s.marketRepository.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*model.Market)
+	for i := range *markets {
+		m := &(*markets)[i]
+		if predicate(m) {
+			res[m.Name] = m
+		}
+	}
+
+	return res, nil
+}
+
+func (s *MarketService) get(ctx context.Context, name string) (*instrument_service.Market, error) {
+	market, err := s.marketRepository.GetByName(ctx, name)
+	if err == pgx.ErrNoRows {
+		return nil, status.Error(codes.NotFound, validationErrors.NoMarketFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return market.ToProto(), nil
+}
+
+func validateMarket(m *instrument_service.Market) error {
+	if m.Name == "" {
+		return status.Error(codes.InvalidArgument, "provide market name")
+	}
+	if m.CurrencyCode == "" {
+		return status.Error(codes.InvalidArgument, "provide market currency code")
+	}
+	if m.Calendar == "" {
+		return status.Error(codes.InvalidArgument, "provide market calendar")
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/repo"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/third_party"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+const overviewRefreshQueueSize = 1000
+
+// reportFunctions are the Alpha Vantage functions financial reports are fetched with
+var reportFunctions = map[string]string{
+	third_party.IncomeStatementFunction: model.IncomeStatementMetrics,
+	third_party.BalanceSheetFunction:    model.BalanceSheetMetrics,
+	third_party.CashFlowFunction:        model.CashFlowMetrics,
+	third_party.EarningsFunction:        model.EarningsMetrics,
+}
+
+// refreshJob is a background refresh of the overview or of the financial reports of a symbol
+type refreshJob struct {
+	sym     *instrument_service.Instrument
+	reports bool
+}
+
+func (j refreshJob) key() string {
+	if j.reports {
+		return "reports:" + overviewKey(j.sym)
+	}
+
+	return "overview:" + overviewKey(j.sym)
+}
+
+// OverviewRefresher fetches overviews and financial reports from Alpha Vantage and stores
+// them, either when requested or in the background for data which has gone stale. Symbols
+// are fetched by their mapped Alpha Vantage ticker.
+type OverviewRefresher struct {
+	symbolOverviewRepository *repo.SymbolOverviewRepository
+	fundamentalsRepository   *repo.FundamentalsRepository
+	alphaVantageService      *third_party.AlphaVantageService
+	tickerMappingService     *TickerMappingService
+
+	queue   chan refreshJob
+	mu      sync.Mutex
+	pending map[string]bool
+}
+
+func NewOverviewRefresher(
+	symbolOverviewRepository *repo.SymbolOverviewRepository,
+	fundamentalsRepository *repo.FundamentalsRepository,
+	alphaVantageService *third_party.AlphaVantageService,
+	tickerMappingService *TickerMappingService) *OverviewRefresher {
+	return &OverviewRefresher{
+		symbolOverviewRepository: symbolOverviewRepository,
+		fundamentalsRepository:   fundamentalsRepository,
+		alphaVantageService:      alphaVantageService,
+		tickerMappingService:     tickerMappingService,
+		queue:                    make(chan refreshJob, overviewRefreshQueueSize),
+		pending:                  make(map[string]bool),
+	}
+}
+
+// Run refreshes the enqueued overviews until the context is done
+func (r *OverviewRefresher) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-r.queue:
+			// background refreshes wait for the Alpha Vantage quota when it is used up
+			refreshCtx, c := context.WithTimeout(ctx, 3*time.Minute)
+			var err error
+			if job.reports {
+				err = r.RefreshReports(refreshCtx, job.sym)
+			} else {
+				_, err = r.Refresh(refreshCtx, job.sym)
+			}
+			c()
+			if err != nil {
+				grpclog.Errorf("[OVERVIEW REFRESH] Failed to refresh %s of %s: %v", job.key(), job.sym.Identifier, err)
+			}
+
+			r.mu.Lock()
+			delete(r.pending, job.key())
+			r.mu.Unlock()
+		}
+	}
+}
+
+// Enqueue schedules a background refresh of the symbol's overview, unless one is already
+// pending. The refresh is dropped if the queue is full, as the next request enqueues it again.
+func (r *OverviewRefresher) Enqueue(sym *instrument_service.Instrument) {
+	r.enqueue(refreshJob{sym: sym})
+}
+
+// EnqueueReports schedules a background refresh of the symbol's financial reports
+func (r *OverviewRefresher) EnqueueReports(sym *instrument_service.Instrument) {
+	r.enqueue(refreshJob{sym: sym, reports: true})
+}
+
+func (r *OverviewRefresher) enqueue(job refreshJob) {
+	key := job.key()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.pending[key] {
+		return
+	}
+
+	select {
+	case r.queue <- job:
+		r.pending[key] = true
+	default:
+	}
+}
+
+// Refresh fetches the overview of the symbol, replaces the stored one and adds it to the snapshots.
+// The ticker mapping is marked as failed if Alpha Vantage has no overview for it, so it can be overridden.
+func (r *OverviewRefresher) Refresh(ctx context.Context, sym *instrument_service.Instrument) (*model.InstrumentOverview, error) {
+	mapping, err := r.resolve(ctx, sym)
+	if err != nil {
+		return nil, err
+	}
+
+	extOverview, err := r.alphaVantageService.GetInstrumentOverview(ctx, mapping.Ticker)
+	if status.Code(err) == codes.NotFound {
+		if markErr := r.tickerMappingService.MarkFailed(ctx, mapping, err); markErr != nil {
+			grpclog.Errorf("[OVERVIEW REFRESH] Failed to store ticker mapping failure of %s: %v", sym.Uuid, markErr)
+		}
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+	if err = r.tickerMappingService.MarkResolved(ctx, mapping); err != nil {
+		grpclog.Errorf("[OVERVIEW REFRESH] Failed to store ticker mapping of %s: %v", sym.Uuid, err)
+	}
+
+	overview := extOverview.ToEntity(sym.Uuid, sym.Isin)
+	err = r.symbolOverviewRepository.Upsert(ctx, overview)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.fundamentalsRepository.InsertSnapshot(ctx, overview)
+	if err != nil {
+		return nil, err
+	}
+
+	return overview, nil
+}
+
+// RefreshReports fetches the financial statements and earnings of the symbol and stores their reports.
+// Statements which aren't available for the symbol, e.g. for funds, are skipped. A statement which
+// fails to be fetched doesn't stop the others, but the reports only count as fetched now, and so
+// stop being refreshed, once all statements were.
+func (r *OverviewRefresher) RefreshReports(ctx context.Context, sym *instrument_service.Instrument) error {
+	mapping, err := r.resolve(ctx, sym)
+	if err != nil {
+		return err
+	}
+
+	var reports []model.FinancialReport
+	var firstErr error
+	for function, statement := range reportFunctions {
+		var err error
+		if function == third_party.EarningsFunction {
+			var earnings *model.EarningsResponse
+			earnings, err = r.alphaVantageService.GetEarnings(ctx, mapping.Ticker)
+			if err == nil {
+				reports = append(reports, earnings.ToEntities(sym.Uuid, sym.Isin)...)
+			}
+		} else {
+			var res *model.FinancialStatementResponse
+			res, err = r.alphaVantageService.GetFinancialStatement(ctx, function, mapping.Ticker)
+			if err == nil {
+				reports = append(reports, res.ToEntities(statement, sym.Uuid, sym.Isin)...)
+			}
+		}
+		if err != nil && status.Code(err) != codes.NotFound && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if firstErr != nil {
+		fetchedAt, err := r.fundamentalsRepository.GetLastFetchedAt(ctx, sym.Uuid, sym.Isin)
+		if err != nil {
+			return err
+		}
+		for i := range reports {
+			reports[i].FetchedAt = fetchedAt
+		}
+	}
+
+	if err := r.fundamentalsRepository.UpsertReports(ctx, reports); err != nil {
+		return err
+	}
+
+	return firstErr
+}
+
+// resolve returns the Alpha Vantage ticker mapping of the symbol
+func (r *OverviewRefresher) resolve(ctx context.Context, sym *instrument_service.Instrument) (*model.TickerMapping, error) {
+	symbol := &model.Symbol{Identifier: sym.Identifier, Isin: sym.Isin}
+	if err := symbol.Uuid.Set(sym.Uuid); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid instrument uuid")
+	}
+
+	// Alpha Vantage tickers have no market suffix, so the market isn't needed
+	return r.tickerMappingService.Resolve(ctx, symbol, nil, common.ProviderAlphaVantage)
+}
+
+// QuotaUsage returns the usage of the Alpha Vantage quotas
+func (r *OverviewRefresher) QuotaUsage(ctx context.Context) ([]model.QuotaUsage, error) {
+	return r.alphaVantageService.QuotaUsage(ctx)
+}
+
+// overviewKey is the key the overview of a symbol is shared by
+func overviewKey(sym *instrument_service.Instrument) string {
+	if sym.Isin != "" {
+		return sym.Isin
+	}
+
+	return sym.Uuid
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	validationErrors "github.com/vectorman1/analysis/analysis-api/common/errors"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPeersLimit = 20
+	maxPeersLimit     = 100
+)
+
+// peerRatio is an overview ratio compared between peers. Values Alpha Vantage reports as None
+// are left out of the metrics, but overviews stored before they were kept as null hold zero
+// instead, so zero counts as missing for the ratios where it is ambiguous.
+type peerRatio struct {
+	name          string
+	zeroIsMissing bool
+}
+
+var peerRatios = []peerRatio{
+	{name: "MarketCapitalization", zeroIsMissing: true},
+	{name: "PERatio", zeroIsMissing: true},
+	{name: "PEGRatio", zeroIsMissing: true},
+	{name: "PriceToBookRatio", zeroIsMissing: true},
+	{name: "PriceToSalesRatioTTM", zeroIsMissing: true},
+	{name: "EVToEBITDA", zeroIsMissing: true},
+	{name: "ProfitMargin"},
+	{name: "OperatingMarginTTM"},
+	{name: "ReturnOnEquityTTM"},
+	{name: "DividendYield", zeroIsMissing: true},
+	{name: "Beta", zeroIsMissing: true},
+}
+
+// Peers compares the instrument with the largest instruments of its industry by their ratios
+// and recent performance, and ranks it within the group
+func (s *InstrumentsService) Peers(
+	ctx context.Context,
+	req *instrument_service.PeersRequest) (*instrument_service.PeersResponse, error) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultPeersLimit
+	}
+	if limit > maxPeersLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be at most %d", maxPeersLimit)
+	}
+
+	symbol, err := s.symbolRepository.GetByUuid(ctx, req.Uuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, validationErrors.NoSymbolFound)
+	}
+	target := symbol.ToProto()
+
+	overview, err := s.getStoredOverview(ctx, target)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.NotFound, validationErrors.NoOverviewFoundForSymbol)
+	} else if err != nil {
+		return nil, err
+	}
+	if overview.Industry == "" || overview.Industry == "None" {
+		return nil, status.Error(codes.FailedPrecondition, "instrument has no industry")
+	}
+
+	// more overviews are read than needed, as some may have no listing which isn't deleted
+	overviews, err := s.symbolOverviewRepository.GetByIndustry(ctx, overview.Industry, int64(2*limit))
+	if err != nil {
+		return nil, err
+	}
+
+	var uuids, isins []string
+	for _, o := range overviews {
+		if o.Isin != "" {
+			isins = append(isins, o.Isin)
+		} else {
+			uuids = append(uuids, o.SymbolUuid)
+		}
+	}
+	listings, err := s.symbolRepository.GetScreenListings(ctx, uuids, isins, squirrel.And{})
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*instrument_service.Instrument)
+	for _, listing := range *listings {
+		sym := listing.ToProto()
+		byKey[overviewKey(sym)] = sym
+	}
+
+	// the requested listing stands for its security, and is always in the group
+	targetKey := overviewKey(target)
+	byKey[targetKey] = target
+	peers := []*instrument_service.Peer{}
+	var group []model.InstrumentOverview
+	var hasTarget bool
+	for _, o := range overviews {
+		key := o.Isin
+		if key == "" {
+			key = o.SymbolUuid
+		}
+		sym, ok := byKey[key]
+		if !ok {
+			continue
+		}
+		isTarget := key == targetKey
+		if len(peers) == limit-1 && !hasTarget && !isTarget {
+			continue
+		}
+
+		hasTarget = hasTarget || isTarget
+		peers = append(peers, &instrument_service.Peer{Instrument: sym, Target: isTarget})
+		group = append(group, o)
+		if len(peers) == limit {
+			break
+		}
+	}
+	if !hasTarget {
+		peers = append(peers, &instrument_service.Peer{Instrument: target, Target: true})
+		group = append(group, *overview)
+	}
+
+	var peerUuids []string
+	for _, p := range peers {
+		peerUuids = append(peerUuids, p.Instrument.Uuid)
+	}
+	now := time.Now()
+	periods := map[string]time.Time{
+		"1M":  now.AddDate(0, -1, 0),
+		"3M":  now.AddDate(0, -3, 0),
+		"1Y":  now.AddDate(-1, 0, 0),
+		"YTD": time.Date(now.Year(), 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	closes, err := s.historyRepository.GetCloses(ctx, peerUuids, periods["1Y"])
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range peers {
+		metrics := group[i].Metrics()
+		p.Ratios = make(map[string]float64)
+		for _, ratio := range peerRatios {
+			if v, ok := metrics[ratio.name]; ok && (v != 0 || !ratio.zeroIsMissing) {
+				p.Ratios[ratio.name] = v
+			}
+		}
+
+		p.Performance = make(map[string]float64)
+		for period, since := range periods {
+			if r, ok := priceReturn(closes[p.Instrument.Uuid], since); ok {
+				p.Performance[period] = r
+			}
+		}
+	}
+
+	res := &instrument_service.PeersResponse{
+		Sector:          overview.Sector,
+		Industry:        overview.Industry,
+		Peers:           peers,
+		PercentileRanks: make(map[string]float64),
+	}
+	ratios := func(p *instrument_service.Peer) map[string]float64 { return p.Ratios }
+	performance := func(p *instrument_service.Peer) map[string]float64 { return p.Performance }
+	for _, ratio := range peerRatios {
+		if rank, ok := percentileRank(peers, ratio.name, ratios); ok {
+			res.PercentileRanks[ratio.name] = rank
+		}
+	}
+	for period := range periods {
+		if rank, ok := percentileRank(peers, period, performance); ok {
+			res.PercentileRanks[period] = rank
+		}
+	}
+
+	return res, nil
+}
+
+// priceReturn returns the return from the first close since the given time to the last close
+func priceReturn(closes []model.LastHistory, since time.Time) (float64, bool) {
+	if len(closes) < 2 {
+		return 0, false
+	}
+
+	for _, c := range closes {
+		if c.Timestamp.Before(since) {
+			continue
+		}
+		if c.Close == 0 {
+			return 0, false
+		}
+		return closes[len(closes)-1].Close/c.Close - 1, true
+	}
+
+	return 0, false
+}
+
+// percentileRank returns the percentage of the peers with a value of the metric lower than the
+// target's, counting equal values as half. Peers missing the metric are left out.
+func percentileRank(
+	peers []*instrument_service.Peer,
+	metric string,
+	values func(p *instrument_service.Peer) map[string]float64) (float64, bool) {
+	var target float64
+	var found bool
+	for _, p := range peers {
+		if p.Target {
+			target, found = values(p)[metric]
+		}
+	}
+	if !found {
+		return 0, false
+	}
+
+	var n, below float64
+	for _, p := range peers {
+		v, ok := values(p)[metric]
+		if !ok {
+			continue
+		}
+		n++
+		if v < target {
+			below++
+		} else if v == target {
+			below += 0.5
+		}
+	}
+
+	return below / n * 100, true
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultScreenPageSize = 50
+	maxScreenPageSize     = 200
+)
+
+// screenFields are the overview fields instruments can be screened by, keyed by the
+// name used by clients. Only numeric fields can be sorted by and compared with ranges.
+var screenFields = map[string]common.QueryField{
+	"sector":                     {Column: "sector", Type: common.TextField},
+	"industry":                   {Column: "industry", Type: common.TextField},
+	"country":                    {Column: "country", Type: common.TextField},
+	"marketCapitalization":       {Column: "marketcapitalization", Type: common.NumberField},
+	"ebitda":                     {Column: "ebitda", Type: common.NumberField},
+	"peRatio":                    {Column: "peratio", Type: common.NumberField},
+	"pegRatio":                   {Column: "pegratio", Type: common.NumberField},
+	"bookValue":                  {Column: "bookvalue", Type: common.NumberField},
+	"dividendPerShare":           {Column: "dividendpershare", Type: common.NumberField},
+	"dividendYield":              {Column: "dividendyield", Type: common.NumberField},
+	"eps":                        {Column: "eps", Type: common.NumberField},
+	"profitMargin":               {Column: "profitmargin", Type: common.NumberField},
+	"operatingMarginTTM":         {Column: "operatingmarginttm", Type: common.NumberField},
+	"returnOnAssetsTTM":          {Column: "returnonassetsttm", Type: common.NumberField},
+	"returnOnEquityTTM":          {Column: "returnonequityttm", Type: common.NumberField},
+	"revenueTTM":                 {Column: "revenuettm", Type: common.NumberField},
+	"quarterlyEarningsGrowthYOY": {Column: "quarterlyearningsgrowthyoy", Type: common.NumberField},
+	"quarterlyRevenueGrowthYOY":  {Column: "quarterlyrevenuegrowthyoy", Type: common.NumberField},
+	"trailingPE":                 {Column: "trailingpe", Type: common.NumberField},
+	"forwardPE":                  {Column: "forwardpe", Type: common.NumberField},
+	"priceToSalesRatioTTM":       {Column: "pricetosalesratiottm", Type: common.NumberField},
+	"priceToBookRatio":           {Column: "pricetobookratio", Type: common.NumberField},
+	"evToRevenue":                {Column: "evtorevenue", Type: common.NumberField},
+	"evToEBITDA":                 {Column: "evtoebitda", Type: common.NumberField},
+	"beta":                       {Column: "beta", Type: common.NumberField},
+	"weekHigh52":                 {Column: "weekhigh52", Type: common.NumberField},
+	"weekLow52":                  {Column: "weeklow52", Type: common.NumberField},
+	"payoutRatio":                {Column: "payoutratio", Type: common.NumberField},
+}
+
+// ScreenFundamentals finds the instruments whose overviews match the filters. Each security is
+// returned once, with its primary listing among those matching the instrument filters.
+func (s *InstrumentsService) ScreenFundamentals(
+	ctx context.Context,
+	req *instrument_service.ScreenFundamentalsRequest) (*instrument_service.ScreenFundamentalsResponse, error) {
+	if req.PageSize > maxScreenPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must be at most %d", maxScreenPageSize)
+	}
+
+	screen := &model.FundamentalsScreen{
+		PageSize:   req.PageSize,
+		PageNumber: req.PageNumber,
+	}
+	if screen.PageSize == 0 {
+		screen.PageSize = defaultScreenPageSize
+	}
+	if screen.PageNumber == 0 {
+		screen.PageNumber = 1
+	}
+
+	for _, f := range req.Filters {
+		filter, err := screenFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		screen.Filters = append(screen.Filters, *filter)
+	}
+
+	for _, key := range req.Sort {
+		field, ok := screenFields[key.Field]
+		if !ok || field.Type != common.NumberField {
+			return nil, status.Errorf(codes.InvalidArgument, "can't sort by %s", key.Field)
+		}
+		screen.Sort = append(screen.Sort, model.ScreenSort{Field: field.Column, Ascending: key.Ascending})
+	}
+	if len(screen.Sort) == 0 {
+		screen.Sort = append(screen.Sort, model.ScreenSort{Field: screenFields["marketCapitalization"].Column})
+	}
+
+	// the overviews are limited to the instruments matching the instrument filters
+	conditions, err := instrumentQueryFields.Conditions(fieldFilters(req.InstrumentFilters))
+	if err != nil {
+		return nil, err
+	}
+
+	// the keys are loaded once, so the overviews are paged and counted by single queries
+	uuids, isins, err := s.symbolRepository.GetKeys(ctx, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.screenPage(ctx, screen, conditions, uuids, isins)
+	if err != nil {
+		return nil, err
+	}
+	totalItems, err := s.symbolOverviewRepository.CountScreen(ctx, screen, isins, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instrument_service.ScreenFundamentalsResponse{Items: items, TotalItems: totalItems}, nil
+}
+
+// screenPage returns the requested page of the overviews of the securities and symbols matching
+// the screen, each with its listing matching the instrument conditions
+func (s *InstrumentsService) screenPage(
+	ctx context.Context,
+	screen *model.FundamentalsScreen,
+	conditions squirrel.Sqlizer,
+	uuids []string,
+	isins []string) ([]*instrument_service.ScreenResult, error) {
+	overviews, err := s.symbolOverviewRepository.Screen(
+		ctx,
+		screen,
+		isins,
+		uuids,
+		(screen.PageNumber-1)*screen.PageSize,
+		screen.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var pageUuids, pageIsins []string
+	for _, overview := range overviews {
+		if overview.Isin != "" {
+			pageIsins = append(pageIsins, overview.Isin)
+		} else {
+			pageUuids = append(pageUuids, overview.SymbolUuid)
+		}
+	}
+	listings, err := s.symbolRepository.GetScreenListings(ctx, pageUuids, pageIsins, conditions)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*instrument_service.Instrument)
+	for _, listing := range *listings {
+		sym := listing.ToProto()
+		byKey[overviewKey(sym)] = sym
+	}
+
+	var res []*instrument_service.ScreenResult
+	for i := range overviews {
+		key := overviews[i].Isin
+		if key == "" {
+			key = overviews[i].SymbolUuid
+		}
+		// the listing can only be missing if it was deleted since the keys were loaded
+		sym, ok := byKey[key]
+		if !ok {
+			continue
+		}
+
+		res = append(res, &instrument_service.ScreenResult{
+			Instrument: sym,
+			Overview:   overviews[i].ToProto(),
+		})
+	}
+
+	return res, nil
+}
+
+// forEachKeyPage calls fn with each page of symbol keys, leaving out the ISINs passed with
+// earlier pages, so each security is passed once however many listings it has
+func forEachKeyPage(
+	keys func(after string) ([]string, []string, string, error),
+	fn func(uuids []string, isins []string) error) error {
+	passed := make(map[string]bool)
+	after := ""
+	for {
+		uuids, isins, last, err := keys(after)
+		if err != nil {
+			return err
+		}
+
+		var newIsins []string
+		for _, isin := range isins {
+			if !passed[isin] {
+				passed[isin] = true
+				newIsins = append(newIsins, isin)
+			}
+		}
+		if len(uuids) > 0 || len(newIsins) > 0 {
+			if err = fn(uuids, newIsins); err != nil {
+				return err
+			}
+		}
+
+		if last == "" {
+			return nil
+		}
+		after = last
+	}
+}
+
+// screenFilter validates the filter against the screen fields and parses its values
+func screenFilter(f *instrument_service.FieldFilter) (*model.ScreenFilter, error) {
+	field, ok := screenFields[f.Field]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "can't screen by %s", f.Field)
+	}
+	if len(f.Values) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "provide a value to screen %s by", f.Field)
+	}
+
+	operator := common.FilterOperator(f.Operator)
+	if operator != common.OperatorIn && len(f.Values) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "only the in operator accepts multiple values for %s", f.Field)
+	}
+	if field.Type != common.NumberField &&
+		operator != common.OperatorEq && operator != common.OperatorNeq && operator != common.OperatorIn {
+		return nil, status.Errorf(codes.InvalidArgument, "%s can only be compared for equality", f.Field)
+	}
+	if operator < common.OperatorEq || operator > common.OperatorIn {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown operator for %s", f.Field)
+	}
+
+	filter := &model.ScreenFilter{Field: field.Column, Operator: operator, Numeric: field.Type == common.NumberField}
+	for _, v := range f.Values {
+		if field.Type != common.NumberField {
+			filter.Values = append(filter.Values, v)
+			continue
+		}
+
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid value %q for %s", v, f.Field)
+		}
+		filter.Values = append(filter.Values, n)
+	}
+
+	return filter, nil
+}
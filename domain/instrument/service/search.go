@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// Search finds instruments by ticker, name or ISIN, tolerating typos, and counts the
+// results per market, currency, sector and country. Sectors and countries come from the
+// stored overviews, so only instruments with an overview are counted in and filtered by them.
+func (s *InstrumentsService) Search(
+	ctx context.Context,
+	req *instrument_service.SearchRequest) (*instrument_service.SearchResponse, error) {
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide query")
+	}
+	if req.PageSize > maxSearchPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must be at most %d", maxSearchPageSize)
+	}
+
+	search := &model.InstrumentSearch{
+		Query:      query,
+		Markets:    req.Markets,
+		Currencies: req.Currencies,
+		PageSize:   req.PageSize,
+		PageNumber: req.PageNumber,
+	}
+	if search.PageSize == 0 {
+		search.PageSize = defaultSearchPageSize
+	}
+	if search.PageNumber == 0 {
+		search.PageNumber = 1
+	}
+
+	// sectors and countries are facet values of the overviews, so the keys of the
+	// documents matching them restrict the search of the instruments
+	unrestricted := *search
+	if len(req.Sectors) > 0 || len(req.Countries) > 0 {
+		isins, uuids, err := s.symbolOverviewRepository.GetKeysByCategories(ctx, req.Sectors, req.Countries)
+		if err != nil {
+			return nil, err
+		}
+		search.Restricted = true
+		search.Isins = isins
+		search.Uuids = uuids
+	}
+
+	results, totalItems, err := s.symbolRepository.Search(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	markets, err := s.symbolRepository.SearchFacet(ctx, search, "marketName")
+	if err != nil {
+		return nil, err
+	}
+	currencies, err := s.symbolRepository.SearchFacet(ctx, search, "currencyCode")
+	if err != nil {
+		return nil, err
+	}
+
+	sectors, countries, err := s.countSearchCategories(ctx, &unrestricted, req.Sectors, req.Countries)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &instrument_service.SearchResponse{
+		TotalItems: uint64(totalItems),
+		Markets:    facetsToProto(markets),
+		Currencies: facetsToProto(currencies),
+		Sectors:    facetsToProto(sectors),
+		Countries:  facetsToProto(countries),
+	}
+	for _, r := range *results {
+		res.Items = append(res.Items, r.ToProto())
+	}
+
+	return res, nil
+}
+
+// countSearchCategories counts the overviews of the instruments matching the search per sector and
+// country. The overviews are looked up a page of instruments at a time, as short queries can match
+// most instruments, and each security's overview is counted once however many listings it has.
+func (s *InstrumentsService) countSearchCategories(
+	ctx context.Context,
+	search *model.InstrumentSearch,
+	sectors []string,
+	countries []string) (*[]model.Facet, *[]model.Facet, error) {
+	sectorCounts := make(map[string]uint64)
+	countryCounts := make(map[string]uint64)
+	err := forEachKeyPage(
+		func(after string) ([]string, []string, string, error) {
+			return s.symbolRepository.SearchKeysPage(ctx, search, after, listAllPageSize)
+		},
+		func(uuids []string, isins []string) error {
+			pageSectors, err := s.symbolOverviewRepository.CountByField(ctx, "sector", isins, uuids, nil, countries)
+			if err != nil {
+				return err
+			}
+			pageCountries, err := s.symbolOverviewRepository.CountByField(ctx, "country", isins, uuids, sectors, nil)
+			if err != nil {
+				return err
+			}
+			addFacets(sectorCounts, pageSectors)
+			addFacets(countryCounts, pageCountries)
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sortedFacets(sectorCounts), sortedFacets(countryCounts), nil
+}
+
+func addFacets(counts map[string]uint64, facets *[]model.Facet) {
+	for _, f := range *facets {
+		counts[f.Value] += f.Count
+	}
+}
+
+// sortedFacets returns the counts with the most common value first
+func sortedFacets(counts map[string]uint64) *[]model.Facet {
+	res := make([]model.Facet, 0, len(counts))
+	for value, count := range counts {
+		res = append(res, model.Facet{Value: value, Count: count})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Value < res[j].Value
+	})
+
+	return &res
+}
+
+func facetsToProto(facets *[]model.Facet) []*instrument_service.Facet {
+	var res []*instrument_service.Facet
+	for _, f := range *facets {
+		res = append(res, f.ToProto())
+	}
+
+	return res
+}
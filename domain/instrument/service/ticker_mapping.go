@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	validationErrors "github.com/vectorman1/analysis/analysis-api/common/errors"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/repo"
+	"github.com/vectorman1/analysis/analysis-api/domain/instrument/third_party"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tickerRule describes how the identifier of an instrument is transformed to a provider's ticker
+type tickerRule struct {
+	// classSeparator replaces the separator of share classes, e.g. BRK.B to BRK-B
+	classSeparator string
+	// marketSuffix appends the ticker suffix of the instrument's market, e.g. VOD to VOD.L
+	marketSuffix bool
+}
+
+var tickerRules = map[string]tickerRule{
+	common.ProviderYahoo:        {classSeparator: "-", marketSuffix: true},
+	common.ProviderAlphaVantage: {classSeparator: "-", marketSuffix: false},
+}
+
+type TickerMappingServiceContract interface {
+	Resolve(ctx context.Context, sym *model.Symbol, market *model.Market, provider string) (*model.TickerMapping, error)
+	LookupByIsin(ctx context.Context, sym *model.Symbol, market *model.Market, provider string) (*model.TickerMapping, error)
+	MarkResolved(ctx context.Context, mapping *model.TickerMapping) error
+	MarkFailed(ctx context.Context, mapping *model.TickerMapping, cause error) error
+	Set(ctx context.Context, req *instrument_service.TickerMapping) (*instrument_service.TickerMapping, error)
+	Failures(ctx context.Context, req *instrument_service.MappingFailuresRequest) (*instrument_service.MappingFailuresResponse, error)
+}
+
+type TickerMappingService struct {
+	tickerMappingRepository *repo.TickerMappingRepository
+	symbolRepository        *repo.SymbolRepository
+	yahooService            *third_party.YahooService
+}
+
+func NewTickerMappingService(
+	tickerMappingRepository *repo.TickerMappingRepository,
+	symbolRepository *repo.SymbolRepository,
+	yahooService *third_party.YahooService) *TickerMappingService {
+	return &TickerMappingService{
+		tickerMappingRepository: tickerMappingRepository,
+		symbolRepository:        symbolRepository,
+		yahooService:            yahooService,
+	}
+}
+
+// Resolve returns the ticker of the symbol for the provider. Manual overrides and
+// previously resolved mappings are used first, otherwise the provider's rules are applied.
+func (s *TickerMappingService) Resolve(
+	ctx context.Context,
+	sym *model.Symbol,
+	market *model.Market,
+	provider string) (*model.TickerMapping, error) {
+	rule, ok := tickerRules[provider]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown provider: %s", provider)
+	}
+
+	var u string
+	sym.Uuid.AssignTo(&u)
+
+	stored, err := s.tickerMappingRepository.Get(ctx, u, provider)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, err
+	}
+	if stored != nil && (!stored.Failed || stored.Source == common.TickerMappingManual) {
+		return stored, nil
+	}
+
+	ticker := strings.NewReplacer(".", rule.classSeparator, "/", rule.classSeparator).Replace(sym.Identifier)
+	if rule.marketSuffix && market != nil {
+		ticker += market.TickerSuffix
+	}
+
+	return &model.TickerMapping{
+		SymbolUuid: sym.Uuid,
+		Provider:   provider,
+		Ticker:     ticker,
+		Source:     common.TickerMappingRule,
+		Failed:     stored != nil && stored.Failed,
+		Stored:     stored != nil,
+	}, nil
+}
+
+// LookupByIsin finds the ticker of the symbol for the provider by its ISIN, on the symbol's market
+func (s *TickerMappingService) LookupByIsin(
+	ctx context.Context,
+	sym *model.Symbol,
+	market *model.Market,
+	provider string) (*model.TickerMapping, error) {
+	if provider != common.ProviderYahoo {
+		return nil, status.Errorf(codes.Unimplemented, "isin lookup is not supported for provider: %s", provider)
+	}
+	if sym.Isin == "" {
+		return nil, status.Error(codes.FailedPrecondition, "symbol has no isin")
+	}
+	if market == nil {
+		return nil, status.Error(codes.FailedPrecondition, "symbol's market is unknown")
+	}
+
+	ticker, err := s.yahooService.SearchByIsin(ctx, sym.Isin, market.TickerSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TickerMapping{
+		SymbolUuid: sym.Uuid,
+		Provider:   provider,
+		Ticker:     ticker,
+		Source:     common.TickerMappingIsin,
+	}, nil
+}
+
+// MarkResolved stores a mapping which returned data from the provider, if it isn't stored already
+func (s *TickerMappingService) MarkResolved(ctx context.Context, mapping *model.TickerMapping) error {
+	if mapping.Stored && !mapping.Failed {
+		return nil
+	}
+
+	mapping.Failed = false
+	mapping.Error = pgtype.Text{Status: pgtype.Null}
+	return s.tickerMappingRepository.Upsert(ctx, mapping)
+}
+
+// MarkFailed stores a mapping for which the provider returned no data, along with the cause
+func (s *TickerMappingService) MarkFailed(ctx context.Context, mapping *model.TickerMapping, cause error) error {
+	mapping.Failed = true
+	mapping.Error = pgtype.Text{String: cause.Error(), Status: pgtype.Present}
+	return s.tickerMappingRepository.Upsert(ctx, mapping)
+}
+
+// Set stores a manual override of the ticker of an instrument for a provider
+func (s *TickerMappingService) Set(ctx context.Context, req *instrument_service.TickerMapping) (*instrument_service.TickerMapping, error) {
+	if _, ok := tickerRules[req.Provider]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown provider: %s", req.Provider)
+	}
+	if req.Ticker == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide ticker")
+	}
+
+	sym, err := s.symbolRepository.GetByUuid(ctx, req.InstrumentUuid)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, validationErrors.NoSymbolFound)
+	}
+
+	mapping := &model.TickerMapping{
+		SymbolUuid: sym.Uuid,
+		Provider:   req.Provider,
+		Ticker:     req.Ticker,
+		Source:     common.TickerMappingManual,
+		Error:      pgtype.Text{Status: pgtype.Null},
+	}
+	err = s.tickerMappingRepository.Upsert(ctx, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.tickerMappingRepository.Get(ctx, req.InstrumentUuid, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	res := stored.ToProto()
+	res.Instrument = sym.ToProto()
+	return res, nil
+}
+
+// Failures lists the instruments whose ticker couldn't be resolved. The instruments are loaded in
+// one query, and failures of deleted instruments are left out.
+func (s *TickerMappingService) Failures(
+	ctx context.Context,
+	req *instrument_service.MappingFailuresRequest) (*instrument_service.MappingFailuresResponse, error) {
+	mappings, err := s.tickerMappingRepository.GetFailed(ctx, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*instrument_service.TickerMapping, len(*mappings))
+	keys := make([]model.InstrumentKey, len(*mappings))
+	for i, m := range *mappings {
+		items[i] = m.ToProto()
+		keys[i] = model.InstrumentKey{Uuid: items[i].InstrumentUuid}
+	}
+	symbols, err := s.symbolRepository.GetByKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*instrument_service.TickerMapping
+	for i, item := range items {
+		if matches := symbols[i]; len(matches) > 0 {
+			item.Instrument = matches[0].ToProto()
+			res = append(res, item)
+		}
+	}
+
+	return &instrument_service.MappingFailuresResponse{Items: res}, nil
+}
@@ -1,16 +1,21 @@
 package third_party
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
 
 	validationErrors "github.com/vectorman1/analysis/analysis-api/common/errors"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/status"
 
 	"github.com/vectorman1/analysis/analysis-api/common"
@@ -18,49 +23,288 @@ import (
 
 const (
 	SymbolOverviewEndpoint = "https://www.alphavantage.co/query?function=OVERVIEW&symbol=%s&apikey=%s"
+	FundamentalsEndpoint   = "https://www.alphavantage.co/query?function=%s&symbol=%s&apikey=%s"
 )
 
-type alphaVantageService interface {
+// Alpha Vantage functions of the financial statements
+const (
+	IncomeStatementFunction = "INCOME_STATEMENT"
+	BalanceSheetFunction    = "BALANCE_SHEET"
+	CashFlowFunction        = "CASH_FLOW"
+	EarningsFunction        = "EARNINGS"
+)
+
+// Alpha Vantage's free tier limits, used when no quotas are configured
+const (
+	defaultAlphaVantageMinuteQuota = 5
+	defaultAlphaVantageDailyQuota  = 500
+)
+
+// number of times a request is retried after Alpha Vantage reports it as rate limited
+const maxThrottledRetries = 2
+
+// AlphaVantageErrorKind classifies the error and notice payloads of Alpha Vantage,
+// which are returned with a 200 status in place of the requested data
+type AlphaVantageErrorKind int
+
+const (
+	// AlphaVantageRateLimited is a notice that the request rate of the key was exceeded
+	AlphaVantageRateLimited AlphaVantageErrorKind = iota
+	// AlphaVantageQuotaExhausted is returned without making the request when the configured quota is used up
+	AlphaVantageQuotaExhausted
+	// AlphaVantageInvalidCall is an error message, returned for unknown symbols and invalid parameters
+	AlphaVantageInvalidCall
+	// AlphaVantageInvalidKey is an error message about the API key being invalid or missing
+	AlphaVantageInvalidKey
+	// AlphaVantageNotice is any other notice, e.g. about premium endpoints
+	AlphaVantageNotice
+)
+
+type AlphaVantageError struct {
+	Kind    AlphaVantageErrorKind
+	Message string
+}
+
+func (e *AlphaVantageError) Error() string {
+	return "alpha vantage: " + e.Message
+}
+
+// GRPCStatus maps the error to the status returned to clients
+func (e *AlphaVantageError) GRPCStatus() *status.Status {
+	switch e.Kind {
+	case AlphaVantageRateLimited, AlphaVantageQuotaExhausted:
+		return status.New(codes.ResourceExhausted, e.Error())
+	case AlphaVantageInvalidCall:
+		return status.New(codes.NotFound, e.Error())
+	case AlphaVantageInvalidKey:
+		return status.New(codes.FailedPrecondition, e.Error())
+	default:
+		return status.New(codes.Unavailable, e.Error())
+	}
+}
+
+// alphaVantagePayload holds the fields Alpha Vantage reports errors and notices in
+type alphaVantagePayload struct {
+	Note         string `json:"Note"`
+	Information  string `json:"Information"`
+	ErrorMessage string `json:"Error Message"`
+}
+
+// decodeAlphaVantageError returns the error of the response body, or nil if it holds data
+func decodeAlphaVantageError(body []byte) error {
+	var payload alphaVantagePayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return err
+	}
+
+	switch {
+	case payload.ErrorMessage != "":
+		if strings.Contains(strings.ToLower(payload.ErrorMessage), "apikey") {
+			return &AlphaVantageError{Kind: AlphaVantageInvalidKey, Message: payload.ErrorMessage}
+		}
+		return &AlphaVantageError{Kind: AlphaVantageInvalidCall, Message: payload.ErrorMessage}
+	case payload.Note != "":
+		return &AlphaVantageError{Kind: AlphaVantageRateLimited, Message: payload.Note}
+	case payload.Information != "":
+		info := strings.ToLower(payload.Information)
+		if strings.Contains(info, "rate limit") || strings.Contains(info, "call frequency") {
+			return &AlphaVantageError{Kind: AlphaVantageRateLimited, Message: payload.Information}
+		}
+		return &AlphaVantageError{Kind: AlphaVantageNotice, Message: payload.Information}
+	}
+
+	return nil
+}
+
+// quotaCounter counts the requests made to a provider across all replicas
+type quotaCounter interface {
+	Take(ctx context.Context, provider string, windows []model.QuotaWindow, now time.Time) (*model.QuotaWindow, error)
+	GetUsage(ctx context.Context, provider string, windows []model.QuotaWindow, now time.Time) ([]model.QuotaUsage, error)
+	DeleteBefore(ctx context.Context, provider string, before time.Time) error
 }
 
 type AlphaVantageService struct {
-	alphaVantageService
 	httpClient *http.Client
 	config     *common.Config
+	quota      quotaCounter
+	windows    []model.QuotaWindow
+
+	mu          sync.Mutex
+	lastCleanup time.Time
 }
 
-func NewAlphaVantageService(config *common.Config) *AlphaVantageService {
+func NewAlphaVantageService(config *common.Config, quota quotaCounter) *AlphaVantageService {
 	client := &http.Client{Timeout: 5 * time.Second}
 
+	minuteLimit := config.AlphaVantageMinuteQuota
+	if minuteLimit <= 0 {
+		minuteLimit = defaultAlphaVantageMinuteQuota
+	}
+	dailyLimit := config.AlphaVantageDailyQuota
+	if dailyLimit <= 0 {
+		dailyLimit = defaultAlphaVantageDailyQuota
+	}
+
 	return &AlphaVantageService{
 		config:     config,
 		httpClient: client,
+		quota:      quota,
+		windows: []model.QuotaWindow{
+			{Period: "minute", Length: time.Minute, Limit: minuteLimit},
+			{Period: "day", Length: 24 * time.Hour, Limit: dailyLimit},
+		},
 	}
 }
 
-func (s *AlphaVantageService) GetInstrumentOverview(symbolName string) (*model.InstrumentOverviewResponse, error) {
-	url := fmt.Sprintf(SymbolOverviewEndpoint, symbolName, s.config.AlphaVantageApiKey)
-	request, _ := http.NewRequest(http.MethodGet, url, nil)
+func (s *AlphaVantageService) GetInstrumentOverview(ctx context.Context, symbolName string) (*model.InstrumentOverviewResponse, error) {
+	var result *model.InstrumentOverviewResponse
+	err := s.query(ctx, fmt.Sprintf(SymbolOverviewEndpoint, symbolName, s.config.AlphaVantageApiKey), &result)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Description == "" {
+		return nil, status.Error(codes.NotFound, validationErrors.NoOverviewFoundForSymbol)
+	}
 
-	res, err := s.httpClient.Do(request)
+	return result, nil
+}
+
+// GetFinancialStatement gets the annual and quarterly reports of one of the financial statement functions
+func (s *AlphaVantageService) GetFinancialStatement(ctx context.Context, function string, symbolName string) (*model.FinancialStatementResponse, error) {
+	var result model.FinancialStatementResponse
+	err := s.query(ctx, fmt.Sprintf(FundamentalsEndpoint, function, symbolName, s.config.AlphaVantageApiKey), &result)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
+	if len(result.AnnualReports) == 0 && len(result.QuarterlyReports) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no %s reports found for symbol", function)
+	}
+
+	return &result, nil
+}
 
-	body, err := ioutil.ReadAll(res.Body)
+func (s *AlphaVantageService) GetEarnings(ctx context.Context, symbolName string) (*model.EarningsResponse, error) {
+	var result model.EarningsResponse
+	err := s.query(ctx, fmt.Sprintf(FundamentalsEndpoint, EarningsFunction, symbolName, s.config.AlphaVantageApiKey), &result)
 	if err != nil {
 		return nil, err
 	}
+	if len(result.AnnualEarnings) == 0 && len(result.QuarterlyEarnings) == 0 {
+		return nil, status.Error(codes.NotFound, "no earnings found for symbol")
+	}
 
-	var result *model.InstrumentOverviewResponse
-	err = json.Unmarshal(body, &result)
+	return &result, nil
+}
+
+// QuotaUsage returns the number of requests made in the current minute and day
+func (s *AlphaVantageService) QuotaUsage(ctx context.Context) ([]model.QuotaUsage, error) {
+	return s.quota.GetUsage(ctx, common.ProviderAlphaVantage, s.windows, time.Now())
+}
+
+// query makes the request once the quota allows it and decodes the response into result.
+// Requests which Alpha Vantage rate limits anyway, e.g. as the key is also used elsewhere,
+// are retried in the next minute.
+func (s *AlphaVantageService) query(ctx context.Context, url string, result interface{}) error {
+	for attempt := 0; ; attempt++ {
+		if err := s.takeQuota(ctx); err != nil {
+			return err
+		}
+
+		body, err := s.get(ctx, url)
+		if err != nil {
+			return err
+		}
+
+		err = decodeAlphaVantageError(body)
+		if avErr, ok := err.(*AlphaVantageError); ok && avErr.Kind == AlphaVantageRateLimited && attempt < maxThrottledRetries {
+			if waitErr := waitUntil(ctx, s.windows[0].Start(time.Now()).Add(s.windows[0].Length)); waitErr != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return json.Unmarshal(body, result)
+	}
+}
+
+// takeQuota counts the request against the quotas. When the per-minute quota is used up
+// the request waits for the next minute, unless the context ends before it.
+func (s *AlphaVantageService) takeQuota(ctx context.Context) error {
+	for {
+		exhausted, err := s.quota.Take(ctx, common.ProviderAlphaVantage, s.windows, time.Now())
+		if err != nil {
+			return err
+		}
+		if exhausted == nil {
+			s.cleanupQuota(ctx)
+			return nil
+		}
+
+		if exhausted.Length > time.Minute {
+			return &AlphaVantageError{
+				Kind:    AlphaVantageQuotaExhausted,
+				Message: fmt.Sprintf("quota of %d requests per %s exhausted", exhausted.Limit, exhausted.Period),
+			}
+		}
+		if err = waitUntil(ctx, exhausted.Start(time.Now()).Add(exhausted.Length)); err != nil {
+			return &AlphaVantageError{
+				Kind:    AlphaVantageRateLimited,
+				Message: fmt.Sprintf("quota of %d requests per %s exhausted", exhausted.Limit, exhausted.Period),
+			}
+		}
+	}
+}
+
+// cleanupQuota removes the counts of past windows at most once an hour
+func (s *AlphaVantageService) cleanupQuota(ctx context.Context) {
+	s.mu.Lock()
+	if time.Since(s.lastCleanup) < time.Hour {
+		s.mu.Unlock()
+		return
+	}
+	s.lastCleanup = time.Now()
+	s.mu.Unlock()
+
+	err := s.quota.DeleteBefore(ctx, common.ProviderAlphaVantage, time.Now().Add(-48*time.Hour))
+	if err != nil {
+		grpclog.Errorf("[ALPHA VANTAGE] Failed to clean up quota usage: %v", err)
+	}
+}
+
+func (s *AlphaVantageService) get(ctx context.Context, url string) ([]byte, error) {
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	res, err := s.httpClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
-	if result.Description == "" {
-		return nil, status.Error(codes.NotFound, validationErrors.NoOverviewFoundForSymbol)
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, status.Errorf(codes.Unavailable, "alpha vantage responded with %s", res.Status)
 	}
 
-	return result, nil
+	return ioutil.ReadAll(res.Body)
+}
+
+// waitUntil sleeps until the given time, with a jitter so replicas don't retry at once.
+// It fails right away if the context would end before then.
+func waitUntil(ctx context.Context, t time.Time) error {
+	t = t.Add(time.Duration(rand.Int63n(int64(time.Second))))
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(t) {
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
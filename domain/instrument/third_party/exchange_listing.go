@@ -0,0 +1,63 @@
+package third_party
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+)
+
+// ExchangeListingService reads the instruments of an exchange from a CSV listing
+type ExchangeListingService struct {
+	httpClient *http.Client
+	listing    common.ExchangeListingConfig
+}
+
+func NewExchangeListingService(listing common.ExchangeListingConfig) *ExchangeListingService {
+	return &ExchangeListingService{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		listing:    listing,
+	}
+}
+
+func (s *ExchangeListingService) Name() string {
+	return fmt.Sprintf("%s:%s", common.SourceExchangeListing, s.listing.Name)
+}
+
+func (s *ExchangeListingService) GetLatest(ctx context.Context) (*[]*instrument_service.Instrument, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.listing.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get listing %s: %s", s.listing.Name, res.Status)
+	}
+
+	result, err := parseInstrumentsCsv(res.Body, s.Name(), csvColumns{
+		identifier: s.listing.IdentifierColumn,
+		name:       s.listing.NameColumn,
+		isin:       s.listing.IsinColumn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sym := range *result {
+		sym.MarketName = s.listing.MarketName
+		sym.CurrencyCode = s.listing.CurrencyCode
+		sym.MarketHoursGmt = s.listing.MarketHoursGmt
+		sym.MinimumOrderQuantity = 1
+	}
+
+	return result, nil
+}
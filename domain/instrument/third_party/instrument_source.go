@@ -0,0 +1,127 @@
+package third_party
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+)
+
+// InstrumentSource is a provider of the universe of instruments which are synced
+type InstrumentSource interface {
+	// Name is the name the instruments of the source are tagged with
+	Name() string
+	GetLatest(ctx context.Context) (*[]*instrument_service.Instrument, error)
+}
+
+// csvColumns holds the header names of the columns an instrument is read from
+type csvColumns struct {
+	identifier           string
+	name                 string
+	isin                 string
+	currencyCode         string
+	marketName           string
+	marketHoursGmt       string
+	minimumOrderQuantity string
+}
+
+// parseInstrumentsCsv reads the instruments from a CSV with a header row.
+// Columns which are not configured or missing from the header are left empty.
+func parseInstrumentsCsv(r io.Reader, source string, columns csvColumns) (*[]*instrument_service.Instrument, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %v", err)
+	}
+
+	index := make(map[string]int)
+	for i, h := range header {
+		index[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+
+	value := func(record []string, column string) string {
+		if column == "" {
+			return ""
+		}
+		i, ok := index[strings.ToLower(column)]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	if _, ok := index[strings.ToLower(columns.identifier)]; !ok {
+		return nil, fmt.Errorf("csv is missing the identifier column %s", columns.identifier)
+	}
+
+	var result []*instrument_service.Instrument
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		identifier := value(record, columns.identifier)
+		if identifier == "" {
+			continue
+		}
+
+		moq, _ := strconv.ParseFloat(value(record, columns.minimumOrderQuantity), 32)
+
+		result = append(result, &instrument_service.Instrument{
+			Identifier:           identifier,
+			Name:                 value(record, columns.name),
+			Isin:                 value(record, columns.isin),
+			CurrencyCode:         value(record, columns.currencyCode),
+			MarketName:           value(record, columns.marketName),
+			MarketHoursGmt:       value(record, columns.marketHoursGmt),
+			MinimumOrderQuantity: float32(moq),
+			Source:               source,
+		})
+	}
+
+	return &result, nil
+}
+
+// NewInstrumentSources creates the enabled instrument sources from the configuration,
+// in the configured order of priority
+func NewInstrumentSources(
+	config *common.Config,
+	trading212Service *ExternalSymbolService,
+	uploads instrumentUploadGetter) ([]InstrumentSource, error) {
+	names := config.InstrumentSources
+	if len(names) == 0 {
+		names = []string{common.SourceTrading212}
+	}
+
+	var result []InstrumentSource
+	for _, name := range names {
+		switch name {
+		case common.SourceTrading212:
+			result = append(result, trading212Service)
+		case common.SourceNasdaqTrader:
+			result = append(result, NewNasdaqTraderService())
+		case common.SourceExchangeListing:
+			for _, listing := range config.ExchangeListings {
+				result = append(result, NewExchangeListingService(listing))
+			}
+		case common.SourceManual:
+			result = append(result, NewManualInstrumentService(uploads))
+		default:
+			return nil, fmt.Errorf("unknown instrument source: %s", name)
+		}
+	}
+
+	return result, nil
+}
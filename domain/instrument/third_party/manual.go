@@ -0,0 +1,52 @@
+package third_party
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+)
+
+type instrumentUploadGetter interface {
+	GetLatest(ctx context.Context) (string, error)
+}
+
+// ManualInstrumentService serves the instruments of the latest manually uploaded CSV
+type ManualInstrumentService struct {
+	uploads instrumentUploadGetter
+}
+
+func NewManualInstrumentService(uploads instrumentUploadGetter) *ManualInstrumentService {
+	return &ManualInstrumentService{uploads: uploads}
+}
+
+func (s *ManualInstrumentService) Name() string {
+	return common.SourceManual
+}
+
+func (s *ManualInstrumentService) GetLatest(ctx context.Context) (*[]*instrument_service.Instrument, error) {
+	content, err := s.uploads.GetLatest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if content == "" {
+		return &[]*instrument_service.Instrument{}, nil
+	}
+
+	return ParseManualInstruments(content)
+}
+
+// ParseManualInstruments parses an uploaded CSV with the columns
+// identifier, name, isin, currency_code, market_name, market_hours_gmt and minimum_order_quantity
+func ParseManualInstruments(content string) (*[]*instrument_service.Instrument, error) {
+	return parseInstrumentsCsv(strings.NewReader(content), common.SourceManual, csvColumns{
+		identifier:           "identifier",
+		name:                 "name",
+		isin:                 "isin",
+		currencyCode:         "currency_code",
+		marketName:           "market_name",
+		marketHoursGmt:       "market_hours_gmt",
+		minimumOrderQuantity: "minimum_order_quantity",
+	})
+}
@@ -0,0 +1,118 @@
+package third_party
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/generated/instrument_service"
+)
+
+// exchange codes used in the NASDAQ Trader otherlisted.txt file
+var nasdaqTraderExchanges = map[string]string{
+	"A": "NYSE American",
+	"N": "NYSE",
+	"P": "NYSE Arca",
+	"Z": "Cboe BZX",
+	"V": "IEX",
+}
+
+type NasdaqTraderService struct {
+	httpClient *http.Client
+}
+
+func NewNasdaqTraderService() *NasdaqTraderService {
+	return &NasdaqTraderService{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (s *NasdaqTraderService) Name() string {
+	return common.SourceNasdaqTrader
+}
+
+// GetLatest reads the instruments listed on NASDAQ and the other US exchanges
+// from the NASDAQ Trader symbol directory. The directory doesn't carry ISINs,
+// so the instruments are matched with other sources by identifier and market.
+func (s *NasdaqTraderService) GetLatest(ctx context.Context) (*[]*instrument_service.Instrument, error) {
+	nasdaqListed, err := s.getFile(ctx, common.NasdaqListedLink)
+	if err != nil {
+		return nil, err
+	}
+	otherListed, err := s.getFile(ctx, common.OtherListedLink)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*instrument_service.Instrument
+	// Symbol|Security Name|Market Category|Test Issue|Financial Status|Round Lot Size|ETF|NextShares
+	for _, row := range nasdaqListed {
+		if len(row) < 4 || row[3] == "Y" {
+			continue
+		}
+		result = append(result, s.toInstrument(row[0], row[1], "NASDAQ"))
+	}
+
+	// ACT Symbol|Security Name|Exchange|CQS Symbol|ETF|Round Lot Size|Test Issue|NASDAQ Symbol
+	for _, row := range otherListed {
+		if len(row) < 7 || row[6] == "Y" {
+			continue
+		}
+		market, ok := nasdaqTraderExchanges[row[2]]
+		if !ok {
+			continue
+		}
+		result = append(result, s.toInstrument(row[0], row[1], market))
+	}
+
+	return &result, nil
+}
+
+func (s *NasdaqTraderService) toInstrument(identifier, name, market string) *instrument_service.Instrument {
+	return &instrument_service.Instrument{
+		Identifier:           identifier,
+		Name:                 name,
+		CurrencyCode:         "USD",
+		MarketName:           market,
+		MinimumOrderQuantity: 1,
+		Source:               common.SourceNasdaqTrader,
+	}
+}
+
+// getFile downloads a pipe delimited symbol directory file and returns
+// its rows, without the header and the trailing file creation time row
+func (s *NasdaqTraderService) getFile(ctx context.Context, url string) ([][]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s: %s", url, res.Status)
+	}
+
+	var rows [][]string
+	scanner := bufio.NewScanner(res.Body)
+	for i := 0; scanner.Scan(); i++ {
+		line := strings.TrimSpace(scanner.Text())
+		if i == 0 || line == "" || strings.HasPrefix(line, "File Creation Time") {
+			continue
+		}
+		rows = append(rows, strings.Split(line, "|"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
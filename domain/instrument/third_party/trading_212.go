@@ -2,7 +2,6 @@ package third_party
 
 import (
 	"context"
-	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -13,16 +12,11 @@ import (
 
 	"github.com/chromedp/chromedp"
 	"github.com/dystopia-systems/alaskalog"
-	"github.com/gofrs/uuid"
 	"github.com/vectorman1/analysis/analysis-api/common"
 	"golang.org/x/net/html"
 	"golang.org/x/sync/errgroup"
 )
 
-type externalSymbolService interface {
-	GetLatest(*context.Context) (*[]*instrument_service.Instrument, error)
-}
-
 type ExternalSymbolService struct {
 }
 
@@ -30,6 +24,10 @@ func NewTrading212Service() *ExternalSymbolService {
 	return &ExternalSymbolService{}
 }
 
+func (s *ExternalSymbolService) Name() string {
+	return common.SourceTrading212
+}
+
 func (s *ExternalSymbolService) GetLatest(ctx context.Context) (*[]*instrument_service.Instrument, error) {
 	bctx, c1 := chromedp.NewContext(
 		ctx,
@@ -114,15 +112,11 @@ func getSymbolData(row []string) (*instrument_service.Instrument, error) {
 	marketName := strings.TrimSpace(row[5])
 	marketHours := strings.TrimSpace(row[6])
 
-	ns, err := uuid.FromString(common.SymbolsNamespace)
+	us, err := common.InstrumentUuid(isin, instrumentName, marketName)
 	if err != nil {
 		return nil, err
 	}
 
-	str := fmt.Sprintf("%s,%s,%s", isin, instrumentName, marketName)
-	u := uuid.NewV5(ns, str)
-	us := u.String()
-
 	return &instrument_service.Instrument{
 		Uuid:                 us,
 		Isin:                 isin,
@@ -132,6 +126,7 @@ func getSymbolData(row []string) (*instrument_service.Instrument, error) {
 		MinimumOrderQuantity: roundedMinQuantity,
 		MarketName:           marketName,
 		MarketHoursGmt:       marketHours,
+		Source:               common.SourceTrading212,
 	}, nil
 }
 
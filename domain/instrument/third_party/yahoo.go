@@ -1,26 +1,91 @@
 package third_party
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/vectorman1/analysis/analysis-api/domain/instrument/model"
 
+	finance "github.com/piquette/finance-go"
 	"github.com/piquette/finance-go/chart"
 	"github.com/piquette/finance-go/datetime"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	YahooSearchEndpoint = "https://query2.finance.yahoo.com/v1/finance/search?q=%s&quotesCount=10&newsCount=0"
+)
+
 type yahooService interface {
 	GetIdentifierHistory(symUuid string, identifier string, start time.Time, end time.Time) (*[]model.History, error)
+	SearchByIsin(ctx context.Context, isin string, tickerSuffix string) (string, error)
 }
 
 type YahooService struct {
 	yahooService
+	httpClient *http.Client
 }
 
 func NewYahooService() *YahooService {
-	return &YahooService{}
+	return &YahooService{
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+type yahooSearchResponse struct {
+	Quotes []struct {
+		Symbol   string `json:"symbol"`
+		Exchange string `json:"exchange"`
+	} `json:"quotes"`
+}
+
+// SearchByIsin looks up the Yahoo ticker of the listing of the instrument with the given ISIN on the
+// market with the ticker suffix. Yahoo has a quote per exchange an ISIN is listed on, whose tickers
+// end with the suffix of the exchange, e.g. VOD.L, or have none for US exchanges.
+func (s *YahooService) SearchByIsin(ctx context.Context, isin string, tickerSuffix string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(YahooSearchEndpoint, url.QueryEscape(isin)), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("yahoo search failed: %s", res.Status)
+	}
+
+	var result yahooSearchResponse
+	err = json.NewDecoder(res.Body).Decode(&result)
+	if err != nil {
+		return "", err
+	}
+	for _, quote := range result.Quotes {
+		if quote.Symbol != "" && yahooTickerSuffix(quote.Symbol) == tickerSuffix {
+			return quote.Symbol, nil
+		}
+	}
+
+	return "", status.Errorf(codes.NotFound, "no yahoo ticker with suffix %q found for isin %s", tickerSuffix, isin)
+}
+
+// yahooTickerSuffix returns the exchange suffix of the Yahoo ticker, with its dot
+func yahooTickerSuffix(ticker string) string {
+	if i := strings.LastIndex(ticker, "."); i >= 0 {
+		return ticker[i:]
+	}
+
+	return ""
 }
 
 func (s *YahooService) GetIdentifierHistory(symUuid string, identifier string, start time.Time, end time.Time) (*[]model.History, error) {
@@ -57,8 +122,21 @@ func (s *YahooService) GetIdentifierHistory(symUuid string, identifier string, s
 	}
 
 	if err := iter.Err(); err != nil {
-		return nil, status.Error(codes.FailedPrecondition, "new history data was null")
+		if isYahooNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "yahoo has no history for %s: %v", identifier, err)
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to get yahoo history of %s: %v", identifier, err)
 	}
 
 	return &result, nil
 }
+
+// isYahooNotFound reports whether Yahoo answered that it has no chart for the ticker,
+// as opposed to the request failing, being throttled or the upstream erroring
+func isYahooNotFound(err error) bool {
+	if _, ok := err.(*finance.YfinError); ok {
+		return true
+	}
+
+	return strings.Contains(err.Error(), "no results in chart response")
+}
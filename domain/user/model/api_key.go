@@ -0,0 +1,54 @@
+package model
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ApiKeyScope uint
+
+const (
+	// ReadOnlyScope allows the read operations of the key's user
+	ReadOnlyScope ApiKeyScope = iota
+	// AdminScope allows every operation of the key's user, who must be an admin
+	AdminScope
+)
+
+// ApiKey authenticates machine clients as its user. Only the hash of the key is stored.
+type ApiKey struct {
+	ID         uint
+	Uuid       pgtype.UUID
+	UserUuid   pgtype.UUID
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scope      ApiKeyScope
+	CreatedAt  pgtype.Timestamptz
+	ExpiresAt  pgtype.Timestamptz
+	LastUsedAt pgtype.Timestamptz
+	RevokedAt  pgtype.Timestamptz
+}
+
+func (e *ApiKey) ToProto() *user_service.ApiKey {
+	var u, userUuid string
+	e.Uuid.AssignTo(&u)
+	e.UserUuid.AssignTo(&userUuid)
+
+	res := &user_service.ApiKey{
+		Uuid:      u,
+		UserUuid:  userUuid,
+		Name:      e.Name,
+		Prefix:    e.Prefix,
+		Scope:     user_service.ApiKey_Scope(e.Scope),
+		CreatedAt: timestamppb.New(e.CreatedAt.Time),
+	}
+	if e.ExpiresAt.Status == pgtype.Present {
+		res.ExpiresAt = timestamppb.New(e.ExpiresAt.Time)
+	}
+	if e.LastUsedAt.Status == pgtype.Present {
+		res.LastUsedAt = timestamppb.New(e.LastUsedAt.Time)
+	}
+
+	return res
+}
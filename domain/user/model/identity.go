@@ -0,0 +1,24 @@
+package model
+
+import "github.com/jackc/pgtype"
+
+// Identity links a user to the subject of an OpenID Connect provider
+type Identity struct {
+	ID          uint
+	UserUuid    pgtype.UUID
+	Issuer      string
+	Subject     string
+	CreatedAt   pgtype.Timestamptz
+	LastLoginAt pgtype.Timestamptz
+}
+
+// OidcLogin is a pending login with an OpenID Connect provider, which is completed once
+// the provider redirects back with its state
+type OidcLogin struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+	// BindingHash is the SHA-256 hash of the cookie set in the browser which started the login
+	BindingHash string
+	CreatedAt   pgtype.Timestamptz
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// results of login attempts
+const (
+	LoginSucceeded = "success"
+	LoginFailed    = "failure"
+	// LoginThrottled attempts were made before the delay after the last failure passed
+	LoginThrottled = "throttled"
+	// LoginLocked attempts were made while the username or address was locked
+	LoginLocked = "locked"
+	// LoginPending attempts are being checked, and count as failures until their result is set
+	LoginPending = "pending"
+	// LoginPassed attempts had the right password or code without signing in, e.g. before the
+	// second factor of a login, and don't reset failures
+	LoginPassed = "passed"
+	// LoginAborted attempts ended with an error before their password or code was checked
+	LoginAborted = "aborted"
+	// LoginUnlocked records an admin unlocking a username or address, which resets its failures
+	LoginUnlocked = "unlock"
+)
+
+type LoginAttempt struct {
+	ID        uint
+	Username  string
+	IpAddress string
+	UserAgent string
+	Result    string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (e *LoginAttempt) ToProto() *user_service.LoginAttempt {
+	return &user_service.LoginAttempt{
+		Id:        uint64(e.ID),
+		Username:  e.Username,
+		IpAddress: e.IpAddress,
+		UserAgent: e.UserAgent,
+		Result:    e.Result,
+		CreatedAt: timestamppb.New(e.CreatedAt.Time),
+	}
+}
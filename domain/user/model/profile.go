@@ -0,0 +1,41 @@
+package model
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Profile holds the profile and preferences of a user
+type Profile struct {
+	ID                  uint
+	UserUuid            pgtype.UUID
+	DisplayName         string
+	Email               string
+	Timezone            string
+	DefaultCurrency     string
+	DefaultChartRange   string
+	PreferredIndicators []string
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+}
+
+func (e *Profile) ToProto() *user_service.Profile {
+	var u string
+	e.UserUuid.AssignTo(&u)
+
+	res := &user_service.Profile{
+		UserUuid:            u,
+		DisplayName:         e.DisplayName,
+		Email:               e.Email,
+		Timezone:            e.Timezone,
+		DefaultCurrency:     e.DefaultCurrency,
+		DefaultChartRange:   e.DefaultChartRange,
+		PreferredIndicators: e.PreferredIndicators,
+	}
+	if e.UpdatedAt.Status == pgtype.Present {
+		res.UpdatedAt = timestamppb.New(e.UpdatedAt.Time)
+	}
+
+	return res
+}
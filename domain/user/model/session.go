@@ -0,0 +1,36 @@
+package model
+
+import (
+	"github.com/jackc/pgtype"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Session is a login of a user, kept alive by refreshing its tokens until it expires or is revoked
+type Session struct {
+	ID                       uint
+	Uuid                     pgtype.UUID
+	UserUuid                 pgtype.UUID
+	RefreshTokenHash         string
+	PreviousRefreshTokenHash pgtype.Text
+	UserAgent                string
+	IpAddress                string
+	CreatedAt                pgtype.Timestamptz
+	LastUsedAt               pgtype.Timestamptz
+	ExpiresAt                pgtype.Timestamptz
+	RevokedAt                pgtype.Timestamptz
+}
+
+func (e *Session) ToProto() *user_service.Session {
+	var u string
+	e.Uuid.AssignTo(&u)
+
+	return &user_service.Session{
+		Uuid:       u,
+		UserAgent:  e.UserAgent,
+		IpAddress:  e.IpAddress,
+		CreatedAt:  timestamppb.New(e.CreatedAt.Time),
+		LastUsedAt: timestamppb.New(e.LastUsedAt.Time),
+		ExpiresAt:  timestamppb.New(e.ExpiresAt.Time),
+	}
+}
@@ -0,0 +1,23 @@
+package model
+
+import "github.com/jackc/pgtype"
+
+// algorithms of signing keys
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+	EdDSA = "EdDSA"
+)
+
+// SigningKey is a key user tokens are signed and verified with. The newest key which isn't
+// retired signs new tokens, while all keys which haven't expired verify them.
+type SigningKey struct {
+	ID         uint
+	Kid        string
+	Algorithm  string
+	PrivateKey []byte
+	PublicKey  []byte
+	CreatedAt  pgtype.Timestamptz
+	RetiredAt  pgtype.Timestamptz
+	ExpiresAt  pgtype.Timestamptz
+}
@@ -22,8 +22,15 @@ type User struct {
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
 	DeletedAt   pgtype.Timestamptz
+	// MustChangePassword is set when an admin chose the password
+	MustChangePassword bool
+	// TotpSecret is the base32 TOTP secret, which is only used for logins once TotpEnabled is set
+	TotpSecret   pgtype.Text
+	TotpEnabled  bool
+	TotpLastStep int64
 }
 
+// ToProto converts the user for responses, leaving out the password hash and TOTP secret
 func (e *User) ToProto() *user_service.User {
 	var u string
 	e.Uuid.AssignTo(&u)
@@ -32,9 +39,11 @@ func (e *User) ToProto() *user_service.User {
 		Id:          uint64(e.ID),
 		Uuid:        u,
 		Username:    e.Username,
-		Password:    e.Password,
 		PrivateRole: uint32(e.PrivateRole),
 		CreatedAt:   timestamppb.New(e.CreatedAt.Time),
 		UpdatedAt:   timestamppb.New(e.UpdatedAt.Time),
+
+		MustChangePassword: e.MustChangePassword,
+		TotpEnabled:        e.TotpEnabled,
 	}
 }
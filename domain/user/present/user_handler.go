@@ -70,3 +70,174 @@ func (s *UserServiceServer) Delete(ctx context.Context, req *user_service.Delete
 
 	return result, nil
 }
+
+func (s *UserServiceServer) Jwks(ctx context.Context, req *user_service.JwksRequest) (*user_service.JwksResponse, error) {
+	result, err := s.userService.Jwks(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) Refresh(ctx context.Context, req *user_service.RefreshRequest) (*user_service.RefreshResponse, error) {
+	result, err := s.userService.Refresh(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) Logout(ctx context.Context, req *user_service.LogoutRequest) (*user_service.LogoutResponse, error) {
+	result, err := s.userService.Logout(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) Sessions(ctx context.Context, req *user_service.SessionsRequest) (*user_service.SessionsResponse, error) {
+	result, err := s.userService.Sessions(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) CreateApiKey(ctx context.Context, req *user_service.CreateApiKeyRequest) (*user_service.CreateApiKeyResponse, error) {
+	result, err := s.userService.CreateApiKey(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) ListApiKeys(ctx context.Context, req *user_service.ListApiKeysRequest) (*user_service.ListApiKeysResponse, error) {
+	result, err := s.userService.ListApiKeys(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) RevokeApiKey(ctx context.Context, req *user_service.RevokeApiKeyRequest) (*user_service.RevokeApiKeyResponse, error) {
+	result, err := s.userService.RevokeApiKey(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) Unlock(ctx context.Context, req *user_service.UnlockRequest) (*user_service.UnlockResponse, error) {
+	result, err := s.userService.Unlock(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) LoginAttempts(ctx context.Context, req *user_service.LoginAttemptsRequest) (*user_service.LoginAttemptsResponse, error) {
+	result, err := s.userService.LoginAttempts(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) ChangePassword(ctx context.Context, req *user_service.ChangePasswordRequest) (*user_service.ChangePasswordResponse, error) {
+	result, err := s.userService.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) LoginMfa(ctx context.Context, req *user_service.LoginMfaRequest) (*user_service.LoginResponse, error) {
+	result, err := s.userService.LoginMfa(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) EnrollTotp(ctx context.Context, req *user_service.EnrollTotpRequest) (*user_service.EnrollTotpResponse, error) {
+	result, err := s.userService.EnrollTotp(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) ConfirmTotp(ctx context.Context, req *user_service.ConfirmTotpRequest) (*user_service.RecoveryCodesResponse, error) {
+	result, err := s.userService.ConfirmTotp(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) DisableTotp(ctx context.Context, req *user_service.DisableTotpRequest) (*user_service.DisableTotpResponse, error) {
+	result, err := s.userService.DisableTotp(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) RegenerateRecoveryCodes(ctx context.Context, req *user_service.RegenerateRecoveryCodesRequest) (*user_service.RecoveryCodesResponse, error) {
+	result, err := s.userService.RegenerateRecoveryCodes(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) OidcAuthorize(ctx context.Context, req *user_service.OidcAuthorizeRequest) (*user_service.OidcAuthorizeResponse, error) {
+	result, err := s.userService.OidcAuthorize(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) OidcCallback(ctx context.Context, req *user_service.OidcCallbackRequest) (*user_service.LoginResponse, error) {
+	result, err := s.userService.OidcCallback(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) GetMe(ctx context.Context, req *user_service.GetMeRequest) (*user_service.Profile, error) {
+	result, err := s.userService.GetMe(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
+
+func (s *UserServiceServer) UpdateMe(ctx context.Context, req *user_service.UpdateMeRequest) (*user_service.Profile, error) {
+	result, err := s.userService.UpdateMe(ctx, req)
+	if err != nil {
+		return nil, common.GetErrorStatus(err)
+	}
+
+	return result, nil
+}
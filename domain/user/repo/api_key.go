@@ -0,0 +1,190 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+)
+
+type ApiKeyRepositoryContract interface {
+	Create(ctx context.Context, key *model.ApiKey) error
+	GetActiveByUser(ctx context.Context, userUuid string) (*[]model.ApiKey, error)
+	GetActiveByHash(ctx context.Context, keyHash string) (*model.ApiKey, *model.User, error)
+	Touch(ctx context.Context, uuid string, olderThan time.Duration) error
+	Revoke(ctx context.Context, userUuid string, uuid string) (bool, error)
+}
+
+type ApiKeyRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewApiKeyRepository(db *pgx.ConnPool) *ApiKeyRepository {
+	return &ApiKeyRepository{
+		db: db,
+	}
+}
+
+const apiKeyColumns = "k.id, k.uuid, k.userUuid, k.name, k.prefix, k.keyHash, k.scope, k.createdAt, k.expiresAt, k.lastUsedAt, k.revokedAt"
+
+func (r *ApiKeyRepository) Create(ctx context.Context, key *model.ApiKey) error {
+	query, args, err := squirrel.
+		Insert("\"user\".api_keys").
+		Columns("uuid, userUuid, name, prefix, keyHash, scope, createdAt, expiresAt").
+		Values(&key.Uuid, &key.UserUuid, key.Name, key.Prefix, key.KeyHash, key.Scope, time.Now(), &key.ExpiresAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// GetActiveByUser returns the keys of the user which weren't revoked and haven't expired, newest first
+func (r *ApiKeyRepository) GetActiveByUser(ctx context.Context, userUuid string) (*[]model.ApiKey, error) {
+	query, args, err := squirrel.
+		Select(apiKeyColumns).
+		From("\"user\".api_keys k").
+		Where(squirrel.Eq{"k.userUuid::text": userUuid}).
+		Where("k.revokedAt is NULL").
+		Where("(k.expiresAt is NULL OR k.expiresAt > now())").
+		OrderBy("k.createdAt desc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.ApiKey
+	for rows.Next() {
+		key := model.ApiKey{}
+		if err = rows.Scan(
+			&key.ID,
+			&key.Uuid,
+			&key.UserUuid,
+			&key.Name,
+			&key.Prefix,
+			&key.KeyHash,
+			&key.Scope,
+			&key.CreatedAt,
+			&key.ExpiresAt,
+			&key.LastUsedAt,
+			&key.RevokedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, key)
+	}
+
+	return &result, nil
+}
+
+// GetActiveByHash returns the active key with the hash and its user, unless the user was deleted
+func (r *ApiKeyRepository) GetActiveByHash(ctx context.Context, keyHash string) (*model.ApiKey, *model.User, error) {
+	query, args, err := squirrel.
+		Select(apiKeyColumns + ", u.uuid, u.privateRole, u.username, u.mustChangePassword, u.totpEnabled").
+		From("\"user\".api_keys k").
+		Join("\"user\".users u ON u.uuid = k.userUuid").
+		Where(squirrel.Eq{"k.keyHash": keyHash}).
+		Where("k.revokedAt is NULL").
+		Where("(k.expiresAt is NULL OR k.expiresAt > now())").
+		Where("u.deletedAt is NULL").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var key model.ApiKey
+	var user model.User
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(
+		&key.ID,
+		&key.Uuid,
+		&key.UserUuid,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Scope,
+		&key.CreatedAt,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+		&user.Uuid,
+		&user.PrivateRole,
+		&user.Username,
+		&user.MustChangePassword,
+		&user.TotpEnabled)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &key, &user, nil
+}
+
+// Touch sets the last use of the key, unless it was set more recently than olderThan ago
+func (r *ApiKeyRepository) Touch(ctx context.Context, uuid string, olderThan time.Duration) error {
+	query, args, err := squirrel.
+		Update("\"user\".api_keys").
+		Set("lastUsedAt", time.Now()).
+		Where(squirrel.Eq{"uuid::text": uuid}).
+		Where(squirrel.Or{
+			squirrel.Eq{"lastUsedAt": nil},
+			squirrel.Lt{"lastUsedAt": time.Now().Add(-olderThan)},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// Revoke revokes the key of the user, returning false if it isn't active
+func (r *ApiKeyRepository) Revoke(ctx context.Context, userUuid string, uuid string) (bool, error) {
+	query, args, err := squirrel.
+		Update("\"user\".api_keys").
+		Set("revokedAt", time.Now()).
+		Where(squirrel.Eq{"userUuid::text": userUuid, "uuid::text": uuid}).
+		Where("revokedAt is NULL").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// RevokeAll revokes the active keys of the user
+func (r *ApiKeyRepository) RevokeAll(ctx context.Context, userUuid string) error {
+	query, args, err := squirrel.
+		Update("\"user\".api_keys").
+		Set("revokedAt", time.Now()).
+		Where(squirrel.Eq{"userUuid::text": userUuid}).
+		Where("revokedAt is NULL").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
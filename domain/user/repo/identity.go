@@ -0,0 +1,109 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+)
+
+type IdentityRepositoryContract interface {
+	Get(ctx context.Context, issuer string, subject string) (*model.Identity, error)
+	Create(ctx context.Context, identity *model.Identity) error
+	CreateWithUser(ctx context.Context, user *model.User, identity *model.Identity) error
+	UpdateLastLogin(ctx context.Context, id uint) error
+}
+
+type IdentityRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewIdentityRepository(db *pgx.ConnPool) *IdentityRepository {
+	return &IdentityRepository{
+		db: db,
+	}
+}
+
+// Get returns the identity of the provider's subject
+func (r *IdentityRepository) Get(ctx context.Context, issuer string, subject string) (*model.Identity, error) {
+	query, args, err := squirrel.
+		Select("id, userUuid, issuer, subject, createdAt, lastLoginAt").
+		From("\"user\".identities").
+		Where(squirrel.Eq{"issuer": issuer, "subject": subject}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res model.Identity
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(
+		&res.ID,
+		&res.UserUuid,
+		&res.Issuer,
+		&res.Subject,
+		&res.CreatedAt,
+		&res.LastLoginAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *IdentityRepository) Create(ctx context.Context, identity *model.Identity) error {
+	return r.insert(ctx, r.db, identity)
+}
+
+// CreateWithUser creates the user and their identity in one transaction, so users created
+// on their first login are never left without the identity they log in with
+func (r *IdentityRepository) CreateWithUser(ctx context.Context, user *model.User, identity *model.Identity) error {
+	tx, err := r.db.BeginEx(ctx, &pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	if err = insertUser(ctx, tx, user); err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+	if err = r.insert(ctx, tx, identity); err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+
+	return tx.CommitEx(ctx)
+}
+
+func (r *IdentityRepository) insert(ctx context.Context, db execer, identity *model.Identity) error {
+	query, args, err := squirrel.
+		Insert("\"user\".identities").
+		Columns("userUuid, issuer, subject, createdAt, lastLoginAt").
+		Values(&identity.UserUuid, identity.Issuer, identity.Subject, time.Now(), time.Now()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+func (r *IdentityRepository) UpdateLastLogin(ctx context.Context, id uint) error {
+	query, args, err := squirrel.
+		Update("\"user\".identities").
+		Set("lastLoginAt", time.Now()).
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
@@ -0,0 +1,209 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx"
+)
+
+type LoginAttemptRepositoryContract interface {
+	Create(ctx context.Context, attempt *model.LoginAttempt) error
+	Reserve(ctx context.Context, attempt *model.LoginAttempt, allow func(failures FailureCounter) error) error
+	SetResult(ctx context.Context, id uint, result string) error
+	GetPaged(ctx context.Context, username string, ipAddress string, pageSize uint64, pageNumber uint64) (*[]model.LoginAttempt, uint, error)
+}
+
+type LoginAttemptRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewLoginAttemptRepository(db *pgx.ConnPool) *LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		db: db,
+	}
+}
+
+func (r *LoginAttemptRepository) Create(ctx context.Context, attempt *model.LoginAttempt) error {
+	query, args, err := squirrel.
+		Insert("\"user\".login_attempts").
+		Columns("username, ipAddress, userAgent, result, createdAt").
+		Values(attempt.Username, attempt.IpAddress, attempt.UserAgent, attempt.Result, time.Now()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// FailureCounter counts the failed logins with the value of the column, username or ipAddress, made
+// since the given time and after the last attempt with one of the reset results. Pending attempts
+// count as failures. It also returns the time of the last failure.
+type FailureCounter func(column string, value string, resets []string, since time.Time) (int, time.Time, error)
+
+// Reserve creates the attempt as pending if allow accepts the failures it counts. The username and
+// the address of the attempt are locked until it is created, so parallel attempts are checked one
+// after the other and count the attempts reserved before them.
+func (r *LoginAttemptRepository) Reserve(
+	ctx context.Context,
+	attempt *model.LoginAttempt,
+	allow func(failures FailureCounter) error) error {
+	tx, err := r.db.BeginEx(ctx, &pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	locks := []string{"username:" + attempt.Username}
+	if attempt.IpAddress != "" {
+		locks = append(locks, "ipAddress:"+attempt.IpAddress)
+	}
+	for _, lock := range locks {
+		if _, err = tx.ExecEx(ctx, "SELECT pg_advisory_xact_lock(hashtext('login_attempts:' || $1))", &pgx.QueryExOptions{}, lock); err != nil {
+			tx.RollbackEx(ctx)
+			return err
+		}
+	}
+
+	err = allow(func(column string, value string, resets []string, since time.Time) (int, time.Time, error) {
+		return r.getFailures(ctx, tx, column, value, resets, since)
+	})
+	if err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+
+	query, args, err := squirrel.
+		Insert("\"user\".login_attempts").
+		Columns("username, ipAddress, userAgent, result, createdAt").
+		Values(attempt.Username, attempt.IpAddress, attempt.UserAgent, model.LoginPending, time.Now()).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+	if err = tx.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(&attempt.ID); err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+	attempt.Result = model.LoginPending
+
+	return tx.CommitEx(ctx)
+}
+
+// SetResult sets the result of the attempt if it is still pending
+func (r *LoginAttemptRepository) SetResult(ctx context.Context, id uint, result string) error {
+	query, args, err := squirrel.
+		Update("\"user\".login_attempts").
+		Set("result", result).
+		Where(squirrel.Eq{"id": id, "result": model.LoginPending}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+type rowQueryer interface {
+	QueryRowEx(ctx context.Context, sql string, options *pgx.QueryExOptions, args ...interface{}) *pgx.Row
+}
+
+func (r *LoginAttemptRepository) getFailures(
+	ctx context.Context,
+	db rowQueryer,
+	column string,
+	value string,
+	resets []string,
+	since time.Time) (int, time.Time, error) {
+	lastReset := squirrel.
+		Select("coalesce(max(createdAt), '-infinity')").
+		From("\"user\".login_attempts").
+		Where(squirrel.Eq{column: value, "result": resets})
+	lastResetSql, lastResetArgs, err := lastReset.ToSql()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	query, args, err := squirrel.
+		Select("count(*), max(createdAt)").
+		From("\"user\".login_attempts").
+		Where(squirrel.Eq{column: value, "result": []string{model.LoginFailed, model.LoginPending}}).
+		Where(squirrel.Gt{"createdAt": since}).
+		Where(squirrel.Expr("createdAt > ("+lastResetSql+")", lastResetArgs...)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	var count int
+	var last pgtype.Timestamptz
+	err = db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(&count, &last)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return count, last.Time, nil
+}
+
+// GetPaged returns the attempts with the username and address, when set, newest first
+func (r *LoginAttemptRepository) GetPaged(
+	ctx context.Context,
+	username string,
+	ipAddress string,
+	pageSize uint64,
+	pageNumber uint64) (*[]model.LoginAttempt, uint, error) {
+	builder := squirrel.
+		Select("id, username, ipAddress, userAgent, result, createdAt, count(*) OVER() AS total_count").
+		From("\"user\".login_attempts").
+		OrderBy("createdAt desc", "id desc").
+		Limit(pageSize).
+		Offset((pageNumber - 1) * pageSize).
+		PlaceholderFormat(squirrel.Dollar)
+	if username != "" {
+		builder = builder.Where(squirrel.Eq{"username": username})
+	}
+	if ipAddress != "" {
+		builder = builder.Where(squirrel.Eq{"ipAddress": ipAddress})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var result []model.LoginAttempt
+	var totalItems uint
+	for rows.Next() {
+		attempt := model.LoginAttempt{}
+		if err = rows.Scan(
+			&attempt.ID,
+			&attempt.Username,
+			&attempt.IpAddress,
+			&attempt.UserAgent,
+			&attempt.Result,
+			&attempt.CreatedAt,
+			&totalItems); err != nil {
+			return nil, 0, err
+		}
+		result = append(result, attempt)
+	}
+
+	return &result, totalItems, nil
+}
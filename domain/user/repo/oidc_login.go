@@ -0,0 +1,85 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+)
+
+type OidcLoginRepositoryContract interface {
+	Create(ctx context.Context, login *model.OidcLogin) error
+	Take(ctx context.Context, state string, after time.Time) (*model.OidcLogin, error)
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+type OidcLoginRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewOidcLoginRepository(db *pgx.ConnPool) *OidcLoginRepository {
+	return &OidcLoginRepository{
+		db: db,
+	}
+}
+
+func (r *OidcLoginRepository) Create(ctx context.Context, login *model.OidcLogin) error {
+	query, args, err := squirrel.
+		Insert("\"user\".oidc_logins").
+		Columns("state, nonce, codeVerifier, bindingHash, createdAt").
+		Values(login.State, login.Nonce, login.CodeVerifier, login.BindingHash, time.Now()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// Take deletes and returns the login with the state if it was started after the given time,
+// so each state can only be used once
+func (r *OidcLoginRepository) Take(ctx context.Context, state string, after time.Time) (*model.OidcLogin, error) {
+	query, args, err := squirrel.
+		Delete("\"user\".oidc_logins").
+		Where(squirrel.Eq{"state": state}).
+		Where(squirrel.Gt{"createdAt": after}).
+		Suffix("RETURNING state, nonce, codeVerifier, bindingHash, createdAt").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res model.OidcLogin
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(
+		&res.State,
+		&res.Nonce,
+		&res.CodeVerifier,
+		&res.BindingHash,
+		&res.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// DeleteBefore removes the logins which were started before the given time and never completed
+func (r *OidcLoginRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	query, args, err := squirrel.
+		Delete("\"user\".oidc_logins").
+		Where(squirrel.Lt{"createdAt": before}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
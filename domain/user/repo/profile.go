@@ -0,0 +1,92 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+)
+
+type ProfileRepositoryContract interface {
+	GetByUser(ctx context.Context, userUuid string) (*model.Profile, error)
+	Upsert(ctx context.Context, profile *model.Profile) error
+}
+
+type ProfileRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewProfileRepository(db *pgx.ConnPool) *ProfileRepository {
+	return &ProfileRepository{
+		db: db,
+	}
+}
+
+// GetByUser returns the profile of the user, which only exists once the user has set it
+func (r *ProfileRepository) GetByUser(ctx context.Context, userUuid string) (*model.Profile, error) {
+	query, args, err := squirrel.
+		Select("id, userUuid, displayName, email, timezone, defaultCurrency, defaultChartRange, preferredIndicators, createdAt, updatedAt").
+		From("\"user\".profiles").
+		Where(squirrel.Eq{"userUuid::text": userUuid}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res model.Profile
+	err = r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...).Scan(
+		&res.ID,
+		&res.UserUuid,
+		&res.DisplayName,
+		&res.Email,
+		&res.Timezone,
+		&res.DefaultCurrency,
+		&res.DefaultChartRange,
+		&res.PreferredIndicators,
+		&res.CreatedAt,
+		&res.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Upsert creates or replaces the profile of the user
+func (r *ProfileRepository) Upsert(ctx context.Context, profile *model.Profile) error {
+	now := time.Now()
+	indicators := profile.PreferredIndicators
+	if indicators == nil {
+		indicators = []string{}
+	}
+
+	query, args, err := squirrel.
+		Insert("\"user\".profiles").
+		Columns("userUuid, displayName, email, timezone, defaultCurrency, defaultChartRange, preferredIndicators, createdAt, updatedAt").
+		Values(
+			&profile.UserUuid,
+			profile.DisplayName,
+			profile.Email,
+			profile.Timezone,
+			profile.DefaultCurrency,
+			profile.DefaultChartRange,
+			indicators,
+			now,
+			now).
+		Suffix("ON CONFLICT (userUuid) DO UPDATE SET " +
+			"displayName = EXCLUDED.displayName, email = EXCLUDED.email, timezone = EXCLUDED.timezone, " +
+			"defaultCurrency = EXCLUDED.defaultCurrency, defaultChartRange = EXCLUDED.defaultChartRange, " +
+			"preferredIndicators = EXCLUDED.preferredIndicators, updatedAt = EXCLUDED.updatedAt").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+)
+
+type RecoveryCodeRepositoryContract interface {
+	Replace(ctx context.Context, userUuid string, codeHashes []string) error
+	Use(ctx context.Context, userUuid string, codeHash string) (bool, error)
+	DeleteByUser(ctx context.Context, userUuid string) error
+}
+
+type RecoveryCodeRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewRecoveryCodeRepository(db *pgx.ConnPool) *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{
+		db: db,
+	}
+}
+
+// Replace removes the codes of the user and stores the new ones
+func (r *RecoveryCodeRepository) Replace(ctx context.Context, userUuid string, codeHashes []string) error {
+	tx, err := r.db.BeginEx(ctx, &pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	query, args, err := squirrel.
+		Delete("\"user\".recovery_codes").
+		Where(squirrel.Eq{"userUuid::text": userUuid}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+	if _, err = tx.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...); err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+
+	builder := squirrel.
+		Insert("\"user\".recovery_codes").
+		Columns("userUuid, codeHash, createdAt").
+		PlaceholderFormat(squirrel.Dollar)
+	for _, hash := range codeHashes {
+		builder = builder.Values(squirrel.Expr("?::uuid", userUuid), hash, time.Now())
+	}
+	query, args, err = builder.ToSql()
+	if err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+	if _, err = tx.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...); err != nil {
+		tx.RollbackEx(ctx)
+		return err
+	}
+
+	return tx.CommitEx(ctx)
+}
+
+// Use marks the unused code of the user as used, returning false if there is none
+func (r *RecoveryCodeRepository) Use(ctx context.Context, userUuid string, codeHash string) (bool, error) {
+	query, args, err := squirrel.
+		Update("\"user\".recovery_codes").
+		Set("usedAt", time.Now()).
+		Where(squirrel.Eq{"userUuid::text": userUuid, "codeHash": codeHash}).
+		Where("usedAt is NULL").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *RecoveryCodeRepository) DeleteByUser(ctx context.Context, userUuid string) error {
+	query, args, err := squirrel.
+		Delete("\"user\".recovery_codes").
+		Where(squirrel.Eq{"userUuid::text": userUuid}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
@@ -0,0 +1,241 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+)
+
+type SessionRepositoryContract interface {
+	Create(ctx context.Context, session *model.Session) error
+	GetActiveByUser(ctx context.Context, userUuid string) (*[]model.Session, error)
+	Rotate(ctx context.Context, refreshTokenHash string, newRefreshTokenHash string, expiresAt time.Time) (*model.Session, error)
+	RevokeByPreviousHash(ctx context.Context, refreshTokenHash string) (bool, error)
+	Revoke(ctx context.Context, userUuid string, sessionUuid string) (bool, error)
+	RevokeAll(ctx context.Context, userUuid string, exceptUuid string) ([]string, error)
+	GetRevokedSince(ctx context.Context, since time.Time) ([]string, error)
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+type SessionRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewSessionRepository(db *pgx.ConnPool) *SessionRepository {
+	return &SessionRepository{
+		db: db,
+	}
+}
+
+const sessionColumns = "id, uuid, userUuid, refreshTokenHash, previousRefreshTokenHash, userAgent, ipAddress, createdAt, lastUsedAt, expiresAt, revokedAt"
+
+func (r *SessionRepository) Create(ctx context.Context, session *model.Session) error {
+	query, args, err := squirrel.
+		Insert("\"user\".sessions").
+		Columns("uuid, userUuid, refreshTokenHash, userAgent, ipAddress, createdAt, lastUsedAt, expiresAt").
+		Values(
+			&session.Uuid,
+			&session.UserUuid,
+			session.RefreshTokenHash,
+			session.UserAgent,
+			session.IpAddress,
+			time.Now(),
+			time.Now(),
+			&session.ExpiresAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// GetActiveByUser returns the sessions of the user which weren't revoked and haven't expired, most recently used first
+func (r *SessionRepository) GetActiveByUser(ctx context.Context, userUuid string) (*[]model.Session, error) {
+	query, args, err := squirrel.
+		Select(sessionColumns).
+		From("\"user\".sessions").
+		Where(squirrel.Eq{"userUuid::text": userUuid}).
+		Where("revokedAt is NULL").
+		Where("expiresAt > now()").
+		OrderBy("lastUsedAt desc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *session)
+	}
+
+	return &result, nil
+}
+
+// Rotate replaces the refresh token of the active session holding it and extends the session.
+// It returns pgx.ErrNoRows if no active session holds the token, so each token is only used once.
+func (r *SessionRepository) Rotate(ctx context.Context, refreshTokenHash string, newRefreshTokenHash string, expiresAt time.Time) (*model.Session, error) {
+	query, args, err := squirrel.
+		Update("\"user\".sessions").
+		Set("refreshTokenHash", newRefreshTokenHash).
+		Set("previousRefreshTokenHash", refreshTokenHash).
+		Set("lastUsedAt", time.Now()).
+		Set("expiresAt", expiresAt).
+		Where(squirrel.Eq{"refreshTokenHash": refreshTokenHash}).
+		Where("revokedAt is NULL").
+		Where("expiresAt > now()").
+		Suffix("RETURNING " + sessionColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanSession(r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...))
+}
+
+// RevokeByPreviousHash revokes the active session whose refresh token replaced the given one,
+// as the reuse of a rotated token means it was stolen
+func (r *SessionRepository) RevokeByPreviousHash(ctx context.Context, refreshTokenHash string) (bool, error) {
+	return r.revokeWhere(ctx, squirrel.Eq{"previousRefreshTokenHash": refreshTokenHash})
+}
+
+// Revoke revokes the session of the user, returning false if it isn't active
+func (r *SessionRepository) Revoke(ctx context.Context, userUuid string, sessionUuid string) (bool, error) {
+	return r.revokeWhere(ctx, squirrel.Eq{"userUuid::text": userUuid, "uuid::text": sessionUuid})
+}
+
+func (r *SessionRepository) revokeWhere(ctx context.Context, pred squirrel.Sqlizer) (bool, error) {
+	query, args, err := squirrel.
+		Update("\"user\".sessions").
+		Set("revokedAt", time.Now()).
+		Where(pred).
+		Where("revokedAt is NULL").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// RevokeAll revokes the active sessions of the user other than exceptUuid, which may be empty,
+// and returns the uuids of the revoked sessions
+func (r *SessionRepository) RevokeAll(ctx context.Context, userUuid string, exceptUuid string) ([]string, error) {
+	builder := squirrel.
+		Update("\"user\".sessions").
+		Set("revokedAt", time.Now()).
+		Where(squirrel.Eq{"userUuid::text": userUuid}).
+		Where("revokedAt is NULL").
+		Suffix("RETURNING uuid::text").
+		PlaceholderFormat(squirrel.Dollar)
+	if exceptUuid != "" {
+		builder = builder.Where(squirrel.NotEq{"uuid::text": exceptUuid})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryUuids(ctx, query, args)
+}
+
+// GetRevokedSince returns the uuids of the sessions revoked after the given time
+func (r *SessionRepository) GetRevokedSince(ctx context.Context, since time.Time) ([]string, error) {
+	query, args, err := squirrel.
+		Select("uuid::text").
+		From("\"user\".sessions").
+		Where(squirrel.Gt{"revokedAt": since}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryUuids(ctx, query, args)
+}
+
+// DeleteBefore removes the sessions which expired or were revoked before the given time
+func (r *SessionRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	query, args, err := squirrel.
+		Delete("\"user\".sessions").
+		Where(squirrel.Or{
+			squirrel.Lt{"expiresAt": before},
+			squirrel.Lt{"revokedAt": before},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+func (r *SessionRepository) queryUuids(ctx context.Context, query string, args []interface{}) ([]string, error) {
+	rows, err := r.db.QueryEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var uuids []string
+	for rows.Next() {
+		var uuid string
+		if err = rows.Scan(&uuid); err != nil {
+			return nil, err
+		}
+		uuids = append(uuids, uuid)
+	}
+
+	return uuids, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSession(row rowScanner) (*model.Session, error) {
+	var session model.Session
+	err := row.Scan(
+		&session.ID,
+		&session.Uuid,
+		&session.UserUuid,
+		&session.RefreshTokenHash,
+		&session.PreviousRefreshTokenHash,
+		&session.UserAgent,
+		&session.IpAddress,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.ExpiresAt,
+		&session.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
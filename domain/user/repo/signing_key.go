@@ -0,0 +1,165 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx"
+)
+
+type SigningKeyRepositoryContract interface {
+	GetValid(ctx context.Context) (*[]model.SigningKey, error)
+	Create(ctx context.Context, key *model.SigningKey) error
+	Rotate(ctx context.Context, currentKid string, expiresAt time.Time, key *model.SigningKey) (bool, error)
+	DeleteExpired(ctx context.Context) error
+	RolloutAt(ctx context.Context) (time.Time, error)
+}
+
+type SigningKeyRepository struct {
+	db *pgx.ConnPool
+}
+
+func NewSigningKeyRepository(db *pgx.ConnPool) *SigningKeyRepository {
+	return &SigningKeyRepository{
+		db: db,
+	}
+}
+
+// GetValid returns the keys which haven't expired, newest first
+func (r *SigningKeyRepository) GetValid(ctx context.Context) (*[]model.SigningKey, error) {
+	query, args, err := squirrel.
+		Select("id, kid, algorithm, privateKey, publicKey, createdAt, retiredAt, expiresAt").
+		From("\"user\".signing_keys").
+		Where("expiresAt is NULL OR expiresAt > now()").
+		OrderBy("createdAt desc", "id desc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []model.SigningKey
+	for rows.Next() {
+		key := model.SigningKey{}
+		if err = rows.Scan(
+			&key.ID,
+			&key.Kid,
+			&key.Algorithm,
+			&key.PrivateKey,
+			&key.PublicKey,
+			&key.CreatedAt,
+			&key.RetiredAt,
+			&key.ExpiresAt); err != nil {
+			return nil, err
+		}
+		result = append(result, key)
+	}
+
+	return &result, nil
+}
+
+func (r *SigningKeyRepository) Create(ctx context.Context, key *model.SigningKey) error {
+	return r.insert(ctx, r.db, key)
+}
+
+// Rotate retires the current key, which verifies tokens until expiresAt, and creates the new one.
+// It returns false without creating the key if the current key was already retired, e.g. by another replica.
+func (r *SigningKeyRepository) Rotate(ctx context.Context, currentKid string, expiresAt time.Time, key *model.SigningKey) (bool, error) {
+	tx, err := r.db.BeginEx(ctx, &pgx.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	query, args, err := squirrel.
+		Update("\"user\".signing_keys").
+		Set("retiredAt", time.Now()).
+		Set("expiresAt", expiresAt).
+		Where(squirrel.Eq{"kid": currentKid}).
+		Where("retiredAt is NULL").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		tx.RollbackEx(ctx)
+		return false, err
+	}
+
+	tag, err := tx.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		tx.RollbackEx(ctx)
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		tx.RollbackEx(ctx)
+		return false, nil
+	}
+
+	if err = r.insert(ctx, tx, key); err != nil {
+		tx.RollbackEx(ctx)
+		return false, err
+	}
+
+	return true, tx.CommitEx(ctx)
+}
+
+// DeleteExpired removes the keys which no longer verify tokens
+func (r *SigningKeyRepository) DeleteExpired(ctx context.Context) error {
+	query, args, err := squirrel.
+		Delete("\"user\".signing_keys").
+		Where("expiresAt <= now()").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// RolloutAt returns when the first signing key was created. It is stored the first time it's
+// requested, from the oldest stored key, so it doesn't move once keys are rotated and deleted.
+func (r *SigningKeyRepository) RolloutAt(ctx context.Context) (time.Time, error) {
+	_, err := r.db.ExecEx(ctx,
+		`INSERT INTO "user".signing_key_rollout (id, rolledOutAt)
+		SELECT 1, coalesce(min(createdAt), now()) FROM "user".signing_keys
+		ON CONFLICT (id) DO NOTHING`,
+		&pgx.QueryExOptions{})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var rolloutAt time.Time
+	err = r.db.QueryRowEx(ctx,
+		`SELECT rolledOutAt FROM "user".signing_key_rollout WHERE id = 1`,
+		&pgx.QueryExOptions{}).
+		Scan(&rolloutAt)
+	return rolloutAt, err
+}
+
+type execer interface {
+	ExecEx(ctx context.Context, sql string, options *pgx.QueryExOptions, arguments ...interface{}) (pgx.CommandTag, error)
+}
+
+func (r *SigningKeyRepository) insert(ctx context.Context, db execer, key *model.SigningKey) error {
+	query, args, err := squirrel.
+		Insert("\"user\".signing_keys").
+		Columns("kid, algorithm, privateKey, publicKey, createdAt").
+		Values(key.Kid, key.Algorithm, key.PrivateKey, key.PublicKey, time.Now()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
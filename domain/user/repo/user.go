@@ -4,23 +4,27 @@ import (
 	"context"
 	"time"
 
-	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
-
 	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx"
 	"github.com/vectorman1/analysis/analysis-api/common"
 )
 
 type UserRepositoryContract interface {
 	GetByUsername(context.Context, string) (*model.User, error)
-	GetPaged(context.Context, *user_service.PagedFilter) (*[]model.User, uint, error)
+	GetByUuid(context.Context, string) (*model.User, error)
+	GetPaged(context.Context, *common.PagedQuery) (*[]model.User, uint, string, error)
 	Create(context.Context, *model.User) error
 	Update(context.Context, *model.User) error
-	Delete(context.Context, string) error
+	Delete(context.Context, string) (bool, error)
+	SetTotp(context.Context, string, pgtype.Text, bool) error
+	UseTotpStep(context.Context, string, int64) (bool, error)
 }
 
+const userColumns = "id, uuid, privateRole, username, password, createdAt, updatedAt, deletedAt, mustChangePassword, totpSecret, totpEnabled, totpLastStep"
+
 type UserRepository struct {
 	db *pgx.ConnPool
 }
@@ -31,18 +35,31 @@ func NewUserRepository(db *pgx.ConnPool) *UserRepository {
 	}
 }
 
+// GetByUsername returns the user with the username, unless it was deleted
 func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*model.User, error) {
-	// Find user with matching username
+	return r.getWhere(ctx, squirrel.Eq{"username": username})
+}
+
+// GetByUuid returns the user with the uuid, unless it was deleted
+func (r *UserRepository) GetByUuid(ctx context.Context, uuid string) (*model.User, error) {
+	return r.getWhere(ctx, squirrel.Eq{"uuid::text": uuid})
+}
+
+func (r *UserRepository) getWhere(ctx context.Context, pred squirrel.Sqlizer) (*model.User, error) {
 	query, args, err := squirrel.
-		Select("*").
+		Select(userColumns).
 		From("\"user\".users").
-		Where(squirrel.Eq{"username": username}).
+		Where(pred).
+		Where("deletedAt is NULL").
 		PlaceholderFormat(squirrel.Dollar).
 		Limit(1).
 		ToSql()
+	if err != nil {
+		return nil, err
+	}
 
 	var res model.User
-	row := r.db.QueryRow(query, args...)
+	row := r.db.QueryRowEx(ctx, query, &pgx.QueryExOptions{}, args...)
 	err = row.Scan(
 		&res.ID,
 		&res.Uuid,
@@ -51,7 +68,11 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*m
 		&res.Password,
 		&res.CreatedAt,
 		&res.UpdatedAt,
-		&res.DeletedAt)
+		&res.DeletedAt,
+		&res.MustChangePassword,
+		&res.TotpSecret,
+		&res.TotpEnabled,
+		&res.TotpLastStep)
 	if err != nil {
 		return nil, err
 	}
@@ -59,30 +80,28 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*m
 	return &res, nil
 }
 
-func (r *UserRepository) GetPaged(ctx context.Context, filter *user_service.PagedFilter) (*[]model.User, uint, error) {
+func (r *UserRepository) GetPaged(ctx context.Context, pagedQuery *common.PagedQuery) (*[]model.User, uint, string, error) {
 	// generate query
-	order := common.FormatOrderQuery(filter.Order, filter.Ascending)
-	query, args, err := squirrel.
-		Select("*, count(*) OVER() AS total_count").
+	query, args, err := pagedQuery.Apply(squirrel.
+		Select(userColumns).
 		From("\"user\".users").
-		OrderBy(order).
-		Offset((filter.PageNumber - 1) * filter.PageSize).
-		Limit(filter.PageSize).
-		Where("deleted_at is NULL").
-		PlaceholderFormat(squirrel.Dollar).
+		Where("deletedAt is NULL").
+		PlaceholderFormat(squirrel.Dollar)).
 		ToSql()
 
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	rows, err := r.db.QueryEx(ctx, query, &pgx.QueryExOptions{}, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
+	defer rows.Close()
 
 	var result []model.User
 	var totalItems uint
+	var cursor string
 	for rows.Next() {
 		user := model.User{}
 		if err = rows.Scan(
@@ -94,27 +113,36 @@ func (r *UserRepository) GetPaged(ctx context.Context, filter *user_service.Page
 			&user.CreatedAt,
 			&user.UpdatedAt,
 			&user.DeletedAt,
-			&totalItems); err != nil {
-			return nil, 0, err
+			&user.MustChangePassword,
+			&user.TotpSecret,
+			&user.TotpEnabled,
+			&user.TotpLastStep,
+			&totalItems,
+			&cursor); err != nil {
+			return nil, 0, "", err
 		}
 		result = append(result, user)
 	}
 
-	return &result, totalItems, nil
+	return &result, totalItems, pagedQuery.NextPageToken(len(result), cursor), nil
 }
 
 func (r *UserRepository) Create(ctx context.Context, user *model.User) error {
+	return insertUser(ctx, r.db, user)
+}
+
+func insertUser(ctx context.Context, db execer, user *model.User) error {
 	query, args, err := squirrel.
 		Insert("\"user\".users").
-		Columns("uuid, privateRole, username, password, createdAt, updatedAt").
-		Values(&user.Uuid, &user.PrivateRole, &user.Username, &user.Password, time.Now(), time.Now()).
+		Columns("uuid, privateRole, username, password, createdAt, updatedAt, mustChangePassword").
+		Values(&user.Uuid, &user.PrivateRole, &user.Username, &user.Password, time.Now(), time.Now(), user.MustChangePassword).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
 		return err
 	}
 
-	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	_, err = db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
 	if err != nil {
 		return err
 	}
@@ -126,10 +154,12 @@ func (r *UserRepository) Update(ctx context.Context, user *model.User) error {
 	query, args, err := squirrel.
 		Update("\"user\".users").
 		Where(squirrel.Eq{"uuid": user.Uuid}).
+		Where("deletedAt is NULL").
 		Set("username", user.Username).
 		Set("password", user.Password).
 		Set("updatedAt", time.Now()).
 		Set("privateRole", user.PrivateRole).
+		Set("mustChangePassword", user.MustChangePassword).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -144,21 +174,65 @@ func (r *UserRepository) Update(ctx context.Context, user *model.User) error {
 	return nil
 }
 
-func (r *UserRepository) Delete(ctx context.Context, uuid string) error {
+// Delete soft deletes the user and returns false if there was no user to delete
+func (r *UserRepository) Delete(ctx context.Context, uuid string) (bool, error) {
 	query, args, err := squirrel.
 		Update("\"user\".users").
-		Where(squirrel.Eq{"uuid": uuid}).
+		Where(squirrel.Eq{"uuid::text": uuid}).
+		Where("deletedAt is NULL").
 		Set("deletedAt", time.Now()).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// SetTotp sets the TOTP secret of the user and whether it is enabled. A NULL secret disables TOTP.
+func (r *UserRepository) SetTotp(ctx context.Context, uuid string, secret pgtype.Text, enabled bool) error {
+	query, args, err := squirrel.
+		Update("\"user\".users").
+		Where(squirrel.Eq{"uuid::text": uuid}).
+		Where("deletedAt is NULL").
+		Set("totpSecret", &secret).
+		Set("totpEnabled", enabled).
+		Set("totpLastStep", 0).
+		Set("updatedAt", time.Now()).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
 	if err != nil {
 		return err
 	}
 
 	_, err = r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	return err
+}
+
+// UseTotpStep records the time step of an accepted TOTP code. It returns false if the step or
+// a later one was already used, so each code is only accepted once.
+func (r *UserRepository) UseTotpStep(ctx context.Context, uuid string, step int64) (bool, error) {
+	query, args, err := squirrel.
+		Update("\"user\".users").
+		Where(squirrel.Eq{"uuid::text": uuid}).
+		Where(squirrel.Lt{"totpLastStep": step}).
+		Set("totpLastStep", step).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	return nil
+	tag, err := r.db.ExecEx(ctx, query, &pgx.QueryExOptions{}, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
 }
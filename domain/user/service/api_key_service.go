@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeyPrefix       = "ak_"
+	apiKeyPrefixLength = len(apiKeyPrefix) + 8
+	maxApiKeysPerUser  = 20
+)
+
+// the last use of a key is only recorded once per this interval, so busy clients don't write on every request
+const apiKeyTouchInterval = time.Minute
+
+// ApiKeyService manages the API keys of users and authenticates the requests made with them
+type ApiKeyService struct {
+	apiKeyRepository *repo.ApiKeyRepository
+	sessionService   *SessionService
+}
+
+func NewApiKeyService(apiKeyRepository *repo.ApiKeyRepository, sessionService *SessionService) *ApiKeyService {
+	return &ApiKeyService{
+		apiKeyRepository: apiKeyRepository,
+		sessionService:   sessionService,
+	}
+}
+
+// Create creates a key of the request's user. Keys can't be created with another key, and only
+// admins can create admin keys.
+func (s *ApiKeyService) Create(ctx context.Context, request *user_service.CreateApiKeyRequest) (*user_service.CreateApiKeyResponse, error) {
+	claims, err := sessionClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(request.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide name")
+	}
+
+	scope := model.ApiKeyScope(request.Scope)
+	if scope != model.ReadOnlyScope && scope != model.AdminScope {
+		return nil, status.Error(codes.InvalidArgument, "unknown scope")
+	}
+	if scope == model.AdminScope && claims.PrivateRole != model.Admin {
+		return nil, status.Error(codes.PermissionDenied, "admin role required for admin keys")
+	}
+
+	key := &model.ApiKey{
+		Uuid:     pgtype.UUID{Status: pgtype.Present},
+		UserUuid: pgtype.UUID{Status: pgtype.Present},
+		Name:     request.Name,
+		Scope:    scope,
+	}
+	if request.ExpiresAt != nil {
+		if !request.ExpiresAt.IsValid() || request.ExpiresAt.AsTime().Before(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expiry must be in the future")
+		}
+		_ = key.ExpiresAt.Set(request.ExpiresAt.AsTime())
+	} else {
+		key.ExpiresAt.Status = pgtype.Null
+	}
+
+	existing, err := s.apiKeyRepository.GetActiveByUser(ctx, claims.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if len(*existing) >= maxApiKeysPerUser {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d API keys can be active", maxApiKeysPerUser)
+	}
+
+	secret, err := newApiKey()
+	if err != nil {
+		return nil, err
+	}
+	key.Prefix = secret[:apiKeyPrefixLength]
+	key.KeyHash = hashApiKey(secret)
+	u, _ := uuid.NewV4()
+	_ = key.Uuid.Set(u.Bytes())
+	if err = key.UserUuid.Set(claims.Uuid); err != nil {
+		return nil, err
+	}
+
+	if err = s.apiKeyRepository.Create(ctx, key); err != nil {
+		return nil, err
+	}
+	key.CreatedAt = pgtype.Timestamptz{Time: time.Now(), Status: pgtype.Present}
+
+	return &user_service.CreateApiKeyResponse{
+		ApiKey: key.ToProto(),
+		Key:    secret,
+	}, nil
+}
+
+// List returns the active keys of the request's user, or of another user for admins
+func (s *ApiKeyService) List(ctx context.Context, request *user_service.ListApiKeysRequest) (*user_service.ListApiKeysResponse, error) {
+	userUuid, err := apiKeyOwner(ctx, request.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.apiKeyRepository.GetActiveByUser(ctx, userUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &user_service.ListApiKeysResponse{ApiKeys: []*user_service.ApiKey{}}
+	for _, key := range *keys {
+		res.ApiKeys = append(res.ApiKeys, key.ToProto())
+	}
+
+	return res, nil
+}
+
+// Revoke revokes a key of the request's user, or of another user for admins
+func (s *ApiKeyService) Revoke(ctx context.Context, request *user_service.RevokeApiKeyRequest) (*user_service.RevokeApiKeyResponse, error) {
+	if request.Uuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide uuid")
+	}
+	userUuid, err := apiKeyOwner(ctx, request.UserUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.apiKeyRepository.Revoke(ctx, userUuid, request.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return nil, status.Error(codes.NotFound, "no active API key found")
+	}
+
+	return &user_service.RevokeApiKeyResponse{}, nil
+}
+
+// RevokeAll revokes the keys of the user, e.g. when the user is deleted
+func (s *ApiKeyService) RevokeAll(ctx context.Context, userUuid string) error {
+	return s.apiKeyRepository.RevokeAll(ctx, userUuid)
+}
+
+// ValidateApiKey returns the claims of the key's user, limited by the key's scope. Keys of users
+// who have to change their password or enroll TOTP are only accepted by the methods which allow it.
+func (s *ApiKeyService) ValidateApiKey(ctx context.Context, key string) (*common.Claims, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+
+	apiKey, user, err := s.apiKeyRepository.GetActiveByHash(ctx, hashApiKey(key))
+	if err == pgx.ErrNoRows {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	} else if err != nil {
+		return nil, err
+	}
+
+	var keyUuid, userUuid string
+	apiKey.Uuid.AssignTo(&keyUuid)
+	user.Uuid.AssignTo(&userUuid)
+
+	if err = s.apiKeyRepository.Touch(ctx, keyUuid, apiKeyTouchInterval); err != nil {
+		grpclog.Errorf("[API KEYS] Failed to record use of key %s: %v", keyUuid, err)
+	}
+
+	claims := &common.Claims{
+		Uuid:        userUuid,
+		PrivateRole: user.PrivateRole,
+		ApiKeyUuid:  keyUuid,
+		ReadOnly:    apiKey.Scope != model.AdminScope,
+
+		MustChangePassword: user.MustChangePassword,
+
+		TotpEnrollmentRequired: s.sessionService.TotpEnrollmentRequired(user),
+	}
+	// admin keys of users who lost the admin role fall back to read-only
+	if user.PrivateRole != model.Admin {
+		claims.ReadOnly = true
+	}
+
+	return claims, nil
+}
+
+// sessionClaims returns the claims of the request, which must not have been made with an API key,
+// for operations which manage the credentials of the user
+func sessionClaims(ctx context.Context) (*common.Claims, error) {
+	claims, ok := common.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "provide user token")
+	}
+	if claims.ApiKeyUuid != "" {
+		return nil, status.Error(codes.PermissionDenied, "user token required, API keys aren't accepted")
+	}
+
+	return claims, nil
+}
+
+// apiKeyOwner returns the user whose keys are managed, which is the request's user unless an admin
+// passed another one
+func apiKeyOwner(ctx context.Context, userUuid string) (string, error) {
+	claims, err := sessionClaims(ctx)
+	if err != nil {
+		return "", err
+	}
+	if userUuid == "" {
+		return claims.Uuid, nil
+	}
+	if _, err = authorizeUser(ctx, userUuid); err != nil {
+		return "", err
+	}
+
+	return userUuid, nil
+}
+
+// newApiKey returns a random key, prefixed so it is recognizable e.g. by secret scanners
+func newApiKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	validation "github.com/vectorman1/analysis/analysis-api/common/errors"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLoginMaxFailures      = 10
+	defaultLoginMaxFailuresPerIp = 50
+	defaultLoginLockout          = 15 * time.Minute
+)
+
+// errLoginDenied aborts the reservation of an attempt which may not be made yet
+var errLoginDenied = errors.New("login denied")
+
+// failed logins older than this are no longer counted
+const loginFailureWindow = 24 * time.Hour
+
+// logins of a username are delayed from this many failures on, with the delay doubling on each failure
+const (
+	loginDelayAfterFailures = 3
+	loginBaseDelay          = time.Second
+	loginMaxDelay           = time.Minute
+)
+
+const (
+	defaultLoginAttemptsPageSize = 50
+	maxLoginAttemptsPageSize     = 500
+)
+
+// LoginGuard protects logins from brute force. Each failed login of a username delays the next
+// attempt longer, until the username is locked after too many failures. Addresses trying many
+// usernames are locked as well. A successful login resets the failures of its username, and
+// admins can unlock usernames and addresses. Every attempt is kept as an audit record.
+type LoginGuard struct {
+	loginAttemptRepository repo.LoginAttemptRepositoryContract
+	maxFailures            int
+	maxFailuresPerIp       int
+	lockout                time.Duration
+}
+
+func NewLoginGuard(loginAttemptRepository repo.LoginAttemptRepositoryContract, config *common.Config) *LoginGuard {
+	g := &LoginGuard{
+		loginAttemptRepository: loginAttemptRepository,
+		maxFailures:            config.LoginMaxFailures,
+		maxFailuresPerIp:       config.LoginMaxFailuresPerIp,
+		lockout:                time.Duration(config.LoginLockoutMinutes) * time.Minute,
+	}
+	if g.maxFailures <= 0 {
+		g.maxFailures = defaultLoginMaxFailures
+	}
+	if g.maxFailuresPerIp <= 0 {
+		g.maxFailuresPerIp = defaultLoginMaxFailuresPerIp
+	}
+	if g.lockout <= 0 {
+		g.lockout = defaultLoginLockout
+	}
+
+	return g
+}
+
+// Check reserves an attempt of the username from the address of the request, or returns an error
+// if they may not attempt to log in yet. The attempt counts as a failure until its result is
+// recorded, so parallel attempts can't each pass the check.
+func (g *LoginGuard) Check(ctx context.Context, username string) (uint, error) {
+	userAgent, ipAddress := clientInfo(ctx)
+	since := time.Now().Add(-loginFailureWindow)
+
+	attempt := &model.LoginAttempt{
+		Username:  username,
+		IpAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+	result := ""
+	var retryAfter time.Time
+	err := g.loginAttemptRepository.Reserve(ctx, attempt, func(getFailures repo.FailureCounter) error {
+		failures, lastFailure, err := getFailures("username", username, []string{model.LoginSucceeded, model.LoginUnlocked}, since)
+		if err != nil {
+			return err
+		}
+
+		if failures >= g.maxFailures {
+			result = model.LoginLocked
+			retryAfter = lastFailure.Add(g.lockout)
+		} else if delay := loginDelay(failures); delay > 0 {
+			result = model.LoginThrottled
+			retryAfter = lastFailure.Add(delay)
+		}
+
+		if ipAddress != "" && (result == "" || time.Now().After(retryAfter)) {
+			// successful logins don't reset the failures of an address, as one account
+			// could otherwise be used to keep guessing the passwords of others
+			ipFailures, ipLastFailure, err := getFailures("ipAddress", ipAddress, []string{model.LoginUnlocked}, since)
+			if err != nil {
+				return err
+			}
+			if ipFailures >= g.maxFailuresPerIp {
+				result = model.LoginLocked
+				retryAfter = ipLastFailure.Add(g.lockout)
+			}
+		}
+
+		if result == "" || time.Now().After(retryAfter) {
+			return nil
+		}
+		return errLoginDenied
+	})
+	if err == nil {
+		return attempt.ID, nil
+	}
+	if err != errLoginDenied {
+		return 0, err
+	}
+
+	g.record(ctx, username, ipAddress, userAgent, result)
+	if result == model.LoginLocked {
+		return 0, status.Error(codes.ResourceExhausted, validation.LoginLocked)
+	}
+	return 0, status.Errorf(codes.ResourceExhausted, "try again in %d seconds", int(math.Ceil(time.Until(retryAfter).Seconds())))
+}
+
+// RecordFailure records that the attempt had a wrong username, password or code
+func (g *LoginGuard) RecordFailure(ctx context.Context, attempt uint) {
+	g.setResult(ctx, attempt, model.LoginFailed)
+}
+
+// RecordSuccess records a successful login, which resets the failures of the username
+func (g *LoginGuard) RecordSuccess(ctx context.Context, attempt uint) {
+	g.setResult(ctx, attempt, model.LoginSucceeded)
+}
+
+// RecordPassed records that the attempt had the right password or code, without resetting the
+// failures of the username, as it didn't complete a login
+func (g *LoginGuard) RecordPassed(ctx context.Context, attempt uint) {
+	g.setResult(ctx, attempt, model.LoginPassed)
+}
+
+// Release records the attempt as aborted unless its result was recorded, which is deferred after
+// Check so attempts ending in errors don't count as failures
+func (g *LoginGuard) Release(ctx context.Context, attempt uint) {
+	g.setResult(ctx, attempt, model.LoginAborted)
+}
+
+// Record stores an attempt which wasn't reserved with Check, as logins with an identity provider
+// are throttled by the provider
+func (g *LoginGuard) Record(ctx context.Context, username string, result string) {
+	userAgent, ipAddress := clientInfo(ctx)
+	g.record(ctx, username, ipAddress, userAgent, result)
+}
+
+// Unlock resets the failures of the username and the address, either of which may be empty
+func (g *LoginGuard) Unlock(ctx context.Context, request *user_service.UnlockRequest) (*user_service.UnlockResponse, error) {
+	if request.Username == "" && request.IpAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide username or ip address")
+	}
+
+	attempt := &model.LoginAttempt{
+		Username:  request.Username,
+		IpAddress: request.IpAddress,
+		Result:    model.LoginUnlocked,
+	}
+	if err := g.loginAttemptRepository.Create(ctx, attempt); err != nil {
+		return nil, err
+	}
+
+	return &user_service.UnlockResponse{}, nil
+}
+
+// Attempts returns a page of the audit records of logins
+func (g *LoginGuard) Attempts(ctx context.Context, request *user_service.LoginAttemptsRequest) (*user_service.LoginAttemptsResponse, error) {
+	if request.PageSize > maxLoginAttemptsPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must be at most %d", maxLoginAttemptsPageSize)
+	}
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = defaultLoginAttemptsPageSize
+	}
+	pageNumber := request.PageNumber
+	if pageNumber == 0 {
+		pageNumber = 1
+	}
+
+	attempts, total, err := g.loginAttemptRepository.GetPaged(ctx, request.Username, request.IpAddress, pageSize, pageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &user_service.LoginAttemptsResponse{TotalItems: uint64(total)}
+	for _, attempt := range *attempts {
+		res.Items = append(res.Items, attempt.ToProto())
+	}
+
+	return res, nil
+}
+
+// record stores the attempt. Failing to do so is only logged, so logins don't depend on the audit log.
+func (g *LoginGuard) record(ctx context.Context, username, ipAddress, userAgent, result string) {
+	err := g.loginAttemptRepository.Create(ctx, &model.LoginAttempt{
+		Username:  username,
+		IpAddress: ipAddress,
+		UserAgent: userAgent,
+		Result:    result,
+	})
+	if err != nil {
+		grpclog.Errorf("[LOGIN GUARD] Failed to record %s login of %s: %v", result, username, err)
+	}
+}
+
+// setResult sets the result of a pending attempt. Failing to do so is only logged, the attempt then
+// keeps counting as a failure.
+func (g *LoginGuard) setResult(ctx context.Context, attempt uint, result string) {
+	if err := g.loginAttemptRepository.SetResult(ctx, attempt, result); err != nil {
+		grpclog.Errorf("[LOGIN GUARD] Failed to record %s result of login attempt %d: %v", result, attempt, err)
+	}
+}
+
+// loginDelay returns how long to wait after the last of the given number of failures
+func loginDelay(failures int) time.Duration {
+	if failures < loginDelayAfterFailures {
+		return 0
+	}
+
+	delay := loginBaseDelay << uint(failures-loginDelayAfterFailures)
+	if delay > loginMaxDelay || delay <= 0 {
+		return loginMaxDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testUsername  = "username"
+	testIpAddress = "203.0.113.7"
+)
+
+// fakeLoginAttemptRepository keeps the attempts like the login_attempts table, and the results set on them
+type fakeLoginAttemptRepository struct {
+	repo.LoginAttemptRepositoryContract
+	attempts []model.LoginAttempt
+	results  []string
+}
+
+func (r *fakeLoginAttemptRepository) Create(_ context.Context, attempt *model.LoginAttempt) error {
+	r.add(attempt, time.Now())
+	return nil
+}
+
+func (r *fakeLoginAttemptRepository) Reserve(
+	_ context.Context,
+	attempt *model.LoginAttempt,
+	allow func(failures repo.FailureCounter) error) error {
+	if err := allow(r.getFailures); err != nil {
+		return err
+	}
+	attempt.Result = model.LoginPending
+	r.add(attempt, time.Now())
+	return nil
+}
+
+func (r *fakeLoginAttemptRepository) SetResult(_ context.Context, id uint, result string) error {
+	r.results = append(r.results, result)
+	for i := range r.attempts {
+		if r.attempts[i].ID == id && r.attempts[i].Result == model.LoginPending {
+			r.attempts[i].Result = result
+		}
+	}
+	return nil
+}
+
+// add stores the attempt created at the time, or right after the last attempt so they stay ordered
+func (r *fakeLoginAttemptRepository) add(attempt *model.LoginAttempt, createdAt time.Time) {
+	if n := len(r.attempts); n > 0 && !createdAt.After(r.attempts[n-1].CreatedAt.Time) {
+		createdAt = r.attempts[n-1].CreatedAt.Time.Add(time.Nanosecond)
+	}
+	attempt.ID = uint(len(r.attempts) + 1)
+	attempt.CreatedAt.Time = createdAt
+	r.attempts = append(r.attempts, *attempt)
+}
+
+func (r *fakeLoginAttemptRepository) getFailures(column string, value string, resets []string, since time.Time) (int, time.Time, error) {
+	matches := func(attempt model.LoginAttempt) bool {
+		if column == "username" {
+			return attempt.Username == value
+		}
+		return attempt.IpAddress == value
+	}
+
+	var lastReset time.Time
+	for _, attempt := range r.attempts {
+		for _, reset := range resets {
+			if matches(attempt) && attempt.Result == reset {
+				lastReset = attempt.CreatedAt.Time
+			}
+		}
+	}
+
+	count := 0
+	var last time.Time
+	for _, attempt := range r.attempts {
+		if !matches(attempt) || !attempt.CreatedAt.Time.After(since) || !attempt.CreatedAt.Time.After(lastReset) {
+			continue
+		}
+		if attempt.Result == model.LoginFailed || attempt.Result == model.LoginPending {
+			count++
+			last = attempt.CreatedAt.Time
+		}
+	}
+	return count, last, nil
+}
+
+// seed stores an attempt made the given time ago
+func (r *fakeLoginAttemptRepository) seed(username, ipAddress, result string, ago time.Duration) {
+	r.add(&model.LoginAttempt{Username: username, IpAddress: ipAddress, Result: result}, time.Now().Add(-ago))
+}
+
+// seedFailures stores failures of the username from the address, the last one made the given time ago
+func (r *fakeLoginAttemptRepository) seedFailures(n int, username, ipAddress string, ago time.Duration) {
+	for i := n - 1; i >= 0; i-- {
+		r.seed(username, ipAddress, model.LoginFailed, ago+time.Duration(i)*time.Millisecond)
+	}
+}
+
+func testClientContext(ipAddress string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ipAddress), Port: 50000}})
+}
+
+func TestLoginGuardCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		seed   func(r *fakeLoginAttemptRepository)
+		result string
+	}{
+		{"no failures", func(r *fakeLoginAttemptRepository) {}, ""},
+		{"failures before the delay", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(loginDelayAfterFailures-1, testUsername, testIpAddress, 0)
+		}, ""},
+		{"failures within the delay", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(loginDelayAfterFailures, testUsername, testIpAddress, 0)
+		}, model.LoginThrottled},
+		{"failures after the delay", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(loginDelayAfterFailures, testUsername, testIpAddress, 2*loginBaseDelay)
+		}, ""},
+		{"pending attempts within the delay", func(r *fakeLoginAttemptRepository) {
+			for i := 0; i < loginDelayAfterFailures; i++ {
+				r.seed(testUsername, testIpAddress, model.LoginPending, 0)
+			}
+		}, model.LoginThrottled},
+		{"aborted and passed attempts", func(r *fakeLoginAttemptRepository) {
+			for i := 0; i < defaultLoginMaxFailures; i++ {
+				r.seed(testUsername, testIpAddress, model.LoginAborted, 0)
+				r.seed(testUsername, testIpAddress, model.LoginPassed, 0)
+			}
+		}, ""},
+		{"locked username", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailures, testUsername, "198.51.100.1", time.Minute)
+		}, model.LoginLocked},
+		{"lockout of username over", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailures, testUsername, testIpAddress, defaultLoginLockout+time.Minute)
+		}, ""},
+		{"failures outside the window", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailures, testUsername, testIpAddress, loginFailureWindow+time.Minute)
+		}, ""},
+		{"failures before a success", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailures, testUsername, testIpAddress, time.Minute)
+			r.seed(testUsername, testIpAddress, model.LoginSucceeded, 0)
+		}, ""},
+		{"failures before an unlock of the username", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailures, testUsername, testIpAddress, time.Minute)
+			r.seed(testUsername, "", model.LoginUnlocked, 0)
+		}, ""},
+		{"locked address", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailuresPerIp, "other", testIpAddress, time.Minute)
+		}, model.LoginLocked},
+		{"locked address after a success", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailuresPerIp, "other", testIpAddress, time.Minute)
+			r.seed("other", testIpAddress, model.LoginSucceeded, 0)
+		}, model.LoginLocked},
+		{"failures before an unlock of the address", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailuresPerIp, "other", testIpAddress, time.Minute)
+			r.seed("", testIpAddress, model.LoginUnlocked, 0)
+		}, ""},
+		{"lockout of address over", func(r *fakeLoginAttemptRepository) {
+			r.seedFailures(defaultLoginMaxFailuresPerIp, "other", testIpAddress, defaultLoginLockout+time.Minute)
+		}, ""},
+	}
+
+	for _, test := range tests {
+		attempts := &fakeLoginAttemptRepository{}
+		test.seed(attempts)
+		seeded := len(attempts.attempts)
+		g := NewLoginGuard(attempts, &common.Config{})
+
+		attempt, err := g.Check(testClientContext(testIpAddress), testUsername)
+		if len(attempts.attempts) != seeded+1 {
+			t.Errorf("%s: stored %d attempts, want 1", test.name, len(attempts.attempts)-seeded)
+			continue
+		}
+		stored := attempts.attempts[seeded]
+		if stored.Username != testUsername || stored.IpAddress != testIpAddress {
+			t.Errorf("%s: stored attempt of %s from %s", test.name, stored.Username, stored.IpAddress)
+		}
+
+		if test.result == "" {
+			if err != nil || attempt != stored.ID || stored.Result != model.LoginPending {
+				t.Errorf("%s: got attempt %d, %v stored as %s, want pending attempt %d", test.name, attempt, err, stored.Result, stored.ID)
+			}
+			continue
+		}
+		if status.Code(err) != codes.ResourceExhausted || attempt != 0 || stored.Result != test.result {
+			t.Errorf("%s: got attempt %d, %v stored as %s, want %s", test.name, attempt, err, stored.Result, test.result)
+		}
+	}
+}
+
+func TestLoginGuardReservesAttempts(t *testing.T) {
+	attempts := &fakeLoginAttemptRepository{}
+	g := NewLoginGuard(attempts, &common.Config{})
+	ctx := testClientContext(testIpAddress)
+
+	// parallel attempts count as failures until their results are recorded
+	var reserved []uint
+	for i := 0; i < loginDelayAfterFailures; i++ {
+		attempt, err := g.Check(ctx, testUsername)
+		if err != nil {
+			t.Fatalf("attempt %d: %v", i+1, err)
+		}
+		reserved = append(reserved, attempt)
+	}
+	if _, err := g.Check(ctx, testUsername); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("attempt beyond the pending ones: %v, want throttled", err)
+	}
+
+	// released attempts don't count, unless their result was recorded before
+	g.RecordFailure(ctx, reserved[0])
+	for _, attempt := range reserved {
+		g.Release(ctx, attempt)
+	}
+	attempt, err := g.Check(ctx, testUsername)
+	if err != nil {
+		t.Fatalf("attempt after releasing: %v", err)
+	}
+
+	// a success resets the failures of the username
+	g.RecordSuccess(ctx, attempt)
+	g.Release(ctx, attempt)
+	want := []string{model.LoginFailed, model.LoginAborted, model.LoginAborted, model.LoginThrottled, model.LoginSucceeded}
+	if len(attempts.attempts) != len(want) {
+		t.Fatalf("stored %d attempts, want %d", len(attempts.attempts), len(want))
+	}
+	for i, a := range attempts.attempts {
+		if a.Result != want[i] {
+			t.Errorf("attempt %d stored as %s, want %s", a.ID, a.Result, want[i])
+		}
+	}
+	if failures, _, _ := attempts.getFailures("username", testUsername, []string{model.LoginSucceeded}, time.Time{}); failures != 0 {
+		t.Errorf("%d failures after a success, want none", failures)
+	}
+}
+
+func TestLoginDelay(t *testing.T) {
+	tests := []struct {
+		failures int
+		delay    time.Duration
+	}{
+		{0, 0},
+		{loginDelayAfterFailures - 1, 0},
+		{loginDelayAfterFailures, loginBaseDelay},
+		{loginDelayAfterFailures + 1, 2 * loginBaseDelay},
+		{loginDelayAfterFailures + 5, 32 * loginBaseDelay},
+		{loginDelayAfterFailures + 6, loginMaxDelay},
+		{loginDelayAfterFailures + 100, loginMaxDelay},
+	}
+
+	for _, test := range tests {
+		if delay := loginDelay(test.failures); delay != test.delay {
+			t.Errorf("delay after %d failures = %v, want %v", test.failures, delay, test.delay)
+		}
+	}
+}
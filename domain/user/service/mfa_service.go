@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	validation "github.com/vectorman1/analysis/analysis-api/common/errors"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultTotpIssuer = "Analysis"
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+// MfaService manages TOTP two-factor authentication and completes the logins of users who use it
+type MfaService struct {
+	userRepository         repo.UserRepositoryContract
+	recoveryCodeRepository repo.RecoveryCodeRepositoryContract
+	tokenService           *TokenService
+	sessionService         *SessionService
+	loginGuard             *LoginGuard
+	issuer                 string
+	requireAdminTotp       bool
+}
+
+func NewMfaService(
+	userRepository repo.UserRepositoryContract,
+	recoveryCodeRepository repo.RecoveryCodeRepositoryContract,
+	tokenService *TokenService,
+	sessionService *SessionService,
+	loginGuard *LoginGuard,
+	config *common.Config) *MfaService {
+	issuer := config.TotpIssuer
+	if issuer == "" {
+		issuer = defaultTotpIssuer
+	}
+
+	return &MfaService{
+		userRepository:         userRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		tokenService:           tokenService,
+		sessionService:         sessionService,
+		loginGuard:             loginGuard,
+		issuer:                 issuer,
+		requireAdminTotp:       config.RequireAdminTotp,
+	}
+}
+
+// CompleteLogin starts a session for the user of the MFA token once the code is verified.
+// Wrong codes count as failed logins of the user.
+func (s *MfaService) CompleteLogin(ctx context.Context, request *user_service.LoginMfaRequest) (*user_service.LoginResponse, error) {
+	if request.MfaToken == "" || request.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide mfa token and code")
+	}
+
+	claims, err := s.tokenService.ValidateMfa(ctx, request.MfaToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa token")
+	}
+	user, err := s.userRepository.GetByUuid(ctx, claims.Uuid)
+	if err == pgx.ErrNoRows || (err == nil && !user.TotpEnabled) {
+		return nil, status.Error(codes.Unauthenticated, "invalid mfa token")
+	} else if err != nil {
+		return nil, err
+	}
+
+	attempt, err := s.loginGuard.Check(ctx, user.Username)
+	if err != nil {
+		return nil, err
+	}
+	defer s.loginGuard.Release(ctx, attempt)
+	if err = s.verifyCode(ctx, user, attempt, request.Code, true); err != nil {
+		return nil, err
+	}
+	s.loginGuard.RecordSuccess(ctx, attempt)
+
+	tokens, err := s.sessionService.Start(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_service.LoginResponse{
+		Token:              tokens.Token,
+		RefreshToken:       tokens.RefreshToken,
+		ExpiresAt:          tokens.ExpiresAt,
+		MustChangePassword: user.MustChangePassword,
+	}, nil
+}
+
+// Enroll creates a pending TOTP secret for the request's user, replacing any other pending one
+func (s *MfaService) Enroll(ctx context.Context, request *user_service.EnrollTotpRequest) (*user_service.EnrollTotpResponse, error) {
+	user, err := s.requestUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.TotpEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := newTotpSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err = s.userRepository.SetTotp(ctx, uuidOf(user), pgtype.Text{String: secret, Status: pgtype.Present}, false); err != nil {
+		return nil, err
+	}
+
+	return &user_service.EnrollTotpResponse{
+		Secret: secret,
+		Uri:    totpUri(s.issuer, user.Username, secret),
+	}, nil
+}
+
+// Confirm enables the pending secret of the request's user with one of its codes and returns
+// new recovery codes. Tokens limited to enrolling TOTP have to be refreshed afterwards.
+func (s *MfaService) Confirm(ctx context.Context, request *user_service.ConfirmTotpRequest) (*user_service.RecoveryCodesResponse, error) {
+	user, err := s.requestUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.TotpEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	if user.TotpSecret.Status != pgtype.Present {
+		return nil, status.Error(codes.FailedPrecondition, "enroll before confirming")
+	}
+
+	step, ok := verifyTotp(user.TotpSecret.String, normalizeCode(request.Code), time.Now())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Wrong code")
+	}
+	if err = s.userRepository.SetTotp(ctx, uuidOf(user), user.TotpSecret, true); err != nil {
+		return nil, err
+	}
+	if _, err = s.userRepository.UseTotpStep(ctx, uuidOf(user), step); err != nil {
+		return nil, err
+	}
+
+	return s.replaceRecoveryCodes(ctx, uuidOf(user))
+}
+
+// Disable turns off two-factor authentication of the request's user, which requires one of their
+// codes, or of another user for admins. Admins can't disable their own when it is required.
+func (s *MfaService) Disable(ctx context.Context, request *user_service.DisableTotpRequest) (*user_service.DisableTotpResponse, error) {
+	claims, err := sessionClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userUuid := claims.Uuid
+	if request.UserUuid != "" && request.UserUuid != claims.Uuid {
+		if _, err = authorizeUser(ctx, request.UserUuid); err != nil {
+			return nil, err
+		}
+		userUuid = request.UserUuid
+	} else {
+		user, err := s.requestUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !user.TotpEnabled {
+			return nil, status.Error(codes.FailedPrecondition, "two-factor authentication isn't enabled")
+		}
+		if s.requireAdminTotp && user.PrivateRole == model.Admin {
+			return nil, status.Error(codes.FailedPrecondition, "admins are required to use two-factor authentication")
+		}
+		attempt, err := s.loginGuard.Check(ctx, user.Username)
+		if err != nil {
+			return nil, err
+		}
+		defer s.loginGuard.Release(ctx, attempt)
+		if err = s.verifyCode(ctx, user, attempt, request.Code, true); err != nil {
+			return nil, err
+		}
+		s.loginGuard.RecordPassed(ctx, attempt)
+	}
+
+	if err = s.userRepository.SetTotp(ctx, userUuid, pgtype.Text{Status: pgtype.Null}, false); err != nil {
+		return nil, err
+	}
+	if err = s.recoveryCodeRepository.DeleteByUser(ctx, userUuid); err != nil {
+		return nil, err
+	}
+
+	return &user_service.DisableTotpResponse{}, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the request's user after verifying a TOTP code
+func (s *MfaService) RegenerateRecoveryCodes(
+	ctx context.Context,
+	request *user_service.RegenerateRecoveryCodesRequest) (*user_service.RecoveryCodesResponse, error) {
+	user, err := s.requestUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !user.TotpEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication isn't enabled")
+	}
+	attempt, err := s.loginGuard.Check(ctx, user.Username)
+	if err != nil {
+		return nil, err
+	}
+	defer s.loginGuard.Release(ctx, attempt)
+	if err = s.verifyCode(ctx, user, attempt, request.Code, false); err != nil {
+		return nil, err
+	}
+	s.loginGuard.RecordPassed(ctx, attempt)
+
+	return s.replaceRecoveryCodes(ctx, uuidOf(user))
+}
+
+// verifyCode checks a TOTP code of the user, or a recovery code if allowed, which are
+// each accepted once. Wrong codes are recorded as failures of the login attempt.
+func (s *MfaService) verifyCode(ctx context.Context, user *model.User, attempt uint, code string, allowRecovery bool) error {
+	code = normalizeCode(code)
+
+	if step, ok := verifyTotp(user.TotpSecret.String, code, time.Now()); ok {
+		fresh, err := s.userRepository.UseTotpStep(ctx, uuidOf(user), step)
+		if err != nil {
+			return err
+		}
+		if fresh {
+			return nil
+		}
+	} else if allowRecovery && len(code) == recoveryCodeLength {
+		used, err := s.recoveryCodeRepository.Use(ctx, uuidOf(user), hashRecoveryCode(code))
+		if err != nil {
+			return err
+		}
+		if used {
+			return nil
+		}
+	}
+
+	s.loginGuard.RecordFailure(ctx, attempt)
+	return status.Error(codes.InvalidArgument, "Wrong code")
+}
+
+func (s *MfaService) replaceRecoveryCodes(ctx context.Context, userUuid string) (*user_service.RecoveryCodesResponse, error) {
+	res := &user_service.RecoveryCodesResponse{}
+	var hashes []string
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		code := totpEncoding.EncodeToString(b)[:recoveryCodeLength]
+
+		hashes = append(hashes, hashRecoveryCode(code))
+		res.RecoveryCodes = append(res.RecoveryCodes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+	}
+
+	if err := s.recoveryCodeRepository.Replace(ctx, userUuid, hashes); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// requestUser returns the user of the request, which must not have been made with an API key
+func (s *MfaService) requestUser(ctx context.Context) (*model.User, error) {
+	claims, err := sessionClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetByUuid(ctx, claims.Uuid)
+	if err == pgx.ErrNoRows {
+		return nil, status.Error(codes.NotFound, validation.NoUserFound)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// normalizeCode removes the separators users may type in codes
+func normalizeCode(code string) string {
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	return strings.ToUpper(code)
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func uuidOf(user *model.User) string {
+	var u string
+	user.Uuid.AssignTo(&u)
+
+	return u
+}
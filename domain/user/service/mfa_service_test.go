@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx"
+)
+
+// fakeUserRepository keeps the users by uuid and the last used TOTP step of the user like the users table
+type fakeUserRepository struct {
+	repo.UserRepositoryContract
+	users    map[string]*model.User
+	lastStep int64
+}
+
+func (r *fakeUserRepository) GetByUuid(_ context.Context, uuid string) (*model.User, error) {
+	user, ok := r.users[uuid]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	stored := *user
+	return &stored, nil
+}
+
+func (r *fakeUserRepository) GetByUsername(_ context.Context, username string) (*model.User, error) {
+	for _, user := range r.users {
+		if user.Username == username {
+			stored := *user
+			return &stored, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (r *fakeUserRepository) Update(_ context.Context, user *model.User) error {
+	stored := *user
+	r.users[uuidOf(user)] = &stored
+	return nil
+}
+
+func (r *fakeUserRepository) UseTotpStep(_ context.Context, _ string, step int64) (bool, error) {
+	if step <= r.lastStep {
+		return false, nil
+	}
+	r.lastStep = step
+	return true, nil
+}
+
+// fakeRecoveryCodeRepository keeps the unused recovery code hashes
+type fakeRecoveryCodeRepository struct {
+	repo.RecoveryCodeRepositoryContract
+	unused map[string]bool
+}
+
+func (r *fakeRecoveryCodeRepository) Use(_ context.Context, _ string, codeHash string) (bool, error) {
+	if !r.unused[codeHash] {
+		return false, nil
+	}
+	delete(r.unused, codeHash)
+	return true, nil
+}
+
+func newTestMfaService(recoveryCodes ...string) (*MfaService, *fakeLoginAttemptRepository) {
+	unused := make(map[string]bool)
+	for _, code := range recoveryCodes {
+		unused[hashRecoveryCode(normalizeCode(code))] = true
+	}
+	attempts := &fakeLoginAttemptRepository{}
+
+	s := NewMfaService(
+		&fakeUserRepository{},
+		&fakeRecoveryCodeRepository{unused: unused},
+		nil,
+		nil,
+		NewLoginGuard(attempts, &common.Config{}),
+		&common.Config{})
+	return s, attempts
+}
+
+func newTestTotpUser(t *testing.T) *model.User {
+	user := &model.User{
+		TotpSecret:  pgtype.Text{String: totpEncoding.EncodeToString([]byte(rfc6238Secret)), Status: pgtype.Present},
+		TotpEnabled: true,
+	}
+	if err := user.Uuid.Set(testUserUuid); err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func currentTotpCode() string {
+	return totpCode([]byte(rfc6238Secret), time.Now().Unix()/totpPeriod)
+}
+
+func TestVerifyCodeAcceptsTotpStepOnce(t *testing.T) {
+	s, attempts := newTestMfaService()
+	user := newTestTotpUser(t)
+	code := currentTotpCode()
+
+	if err := s.verifyCode(context.Background(), user, 1, code, true); err != nil {
+		t.Fatalf("first use of the code failed: %v", err)
+	}
+	if err := s.verifyCode(context.Background(), user, 2, code, true); err == nil {
+		t.Fatal("replayed code was accepted")
+	}
+	if len(attempts.results) != 1 || attempts.results[0] != model.LoginFailed {
+		t.Errorf("recorded results %v, want one failure", attempts.results)
+	}
+}
+
+func TestVerifyCodeRejectsWrongCode(t *testing.T) {
+	s, attempts := newTestMfaService()
+	user := newTestTotpUser(t)
+
+	code := "000000"
+	if code == currentTotpCode() {
+		code = "000001"
+	}
+	if err := s.verifyCode(context.Background(), user, 1, code, true); err == nil {
+		t.Fatal("wrong code was accepted")
+	}
+	if len(attempts.results) != 1 || attempts.results[0] != model.LoginFailed {
+		t.Errorf("recorded results %v, want one failure", attempts.results)
+	}
+}
+
+func TestVerifyCodeAcceptsRecoveryCodeOnce(t *testing.T) {
+	s, attempts := newTestMfaService("abcde-fghjk", "KMNPQ-RSTUV")
+	user := newTestTotpUser(t)
+
+	if err := s.verifyCode(context.Background(), user, 1, "ABCDE-FGHJK", true); err != nil {
+		t.Fatalf("first use of the recovery code failed: %v", err)
+	}
+	if err := s.verifyCode(context.Background(), user, 2, "abcdefghjk", true); err == nil {
+		t.Fatal("used recovery code was accepted again")
+	}
+	if err := s.verifyCode(context.Background(), user, 3, "kmnpq rstuv", true); err != nil {
+		t.Fatalf("other recovery code failed: %v", err)
+	}
+	if len(attempts.results) != 1 || attempts.results[0] != model.LoginFailed {
+		t.Errorf("recorded results %v, want one failure", attempts.results)
+	}
+}
+
+func TestVerifyCodeRejectsRecoveryCodeUnlessAllowed(t *testing.T) {
+	s, _ := newTestMfaService("ABCDE-FGHJK")
+	user := newTestTotpUser(t)
+
+	if err := s.verifyCode(context.Background(), user, 1, "ABCDE-FGHJK", false); err == nil {
+		t.Fatal("recovery code was accepted where it isn't allowed")
+	}
+	if err := s.verifyCode(context.Background(), user, 2, "ABCDE-FGHJK", true); err != nil {
+		t.Fatalf("rejected recovery code was used up: %v", err)
+	}
+}
@@ -0,0 +1,661 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// time users have to log in with the provider after starting the login
+const oidcLoginLifetime = 10 * time.Minute
+
+// the provider's configuration and keys are fetched again after this
+const oidcProviderMaxAge = time.Hour
+
+const (
+	defaultOidcUsernameClaim = "preferred_username"
+	defaultOidcGroupsClaim   = "groups"
+)
+
+var defaultOidcScopes = []string{"profile", "email"}
+
+// oidcSigningMethods are the algorithms ID tokens are accepted with
+var oidcSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// oidcProvider holds the fields of the provider's discovery document which are used
+type oidcProvider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+type oidcJwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// OidcService logs users in with an OpenID Connect provider, using the authorization code flow
+// with PKCE. Users are created on their first login, and their role follows their groups. Groups are
+// only read from ID tokens, so a role changed at the provider applies on the user's next login with it.
+// Logins are recorded with the password logins, while the provider throttles them.
+type OidcService struct {
+	userRepository      repo.UserRepositoryContract
+	identityRepository  repo.IdentityRepositoryContract
+	oidcLoginRepository repo.OidcLoginRepositoryContract
+	tokenService        *TokenService
+	sessionService      *SessionService
+	loginGuard          *LoginGuard
+	passwordPolicy      *PasswordPolicy
+	httpClient          *http.Client
+
+	issuerUrl     string
+	clientId      string
+	clientSecret  string
+	redirectUrl   string
+	scopes        []string
+	usernameClaim string
+	groupsClaim   string
+	adminGroups   []string
+	allowedGroups []string
+
+	mu          sync.Mutex
+	provider    *oidcProvider
+	keys        map[string]interface{}
+	fetchedAt   time.Time
+	lastCleanup time.Time
+}
+
+func NewOidcService(
+	userRepository repo.UserRepositoryContract,
+	identityRepository repo.IdentityRepositoryContract,
+	oidcLoginRepository repo.OidcLoginRepositoryContract,
+	tokenService *TokenService,
+	sessionService *SessionService,
+	loginGuard *LoginGuard,
+	passwordPolicy *PasswordPolicy,
+	config *common.Config) *OidcService {
+	scopes := config.OidcScopes
+	if len(scopes) == 0 {
+		scopes = defaultOidcScopes
+	}
+	usernameClaim := config.OidcUsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultOidcUsernameClaim
+	}
+	groupsClaim := config.OidcGroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = defaultOidcGroupsClaim
+	}
+
+	return &OidcService{
+		userRepository:      userRepository,
+		identityRepository:  identityRepository,
+		oidcLoginRepository: oidcLoginRepository,
+		tokenService:        tokenService,
+		sessionService:      sessionService,
+		loginGuard:          loginGuard,
+		passwordPolicy:      passwordPolicy,
+		httpClient:          &http.Client{Timeout: 5 * time.Second},
+		issuerUrl:           config.OidcIssuerUrl,
+		clientId:            config.OidcClientId,
+		clientSecret:        config.OidcClientSecret,
+		redirectUrl:         config.OidcRedirectUrl,
+		scopes:              scopes,
+		usernameClaim:       usernameClaim,
+		groupsClaim:         groupsClaim,
+		adminGroups:         config.OidcAdminGroups,
+		allowedGroups:       config.OidcAllowedGroups,
+	}
+}
+
+// Authorize starts a login and returns the url of the provider's authorization endpoint. The login
+// is bound to the browser with a cookie, so a callback with its state can't be completed in another.
+func (s *OidcService) Authorize(ctx context.Context, _ *user_service.OidcAuthorizeRequest) (*user_service.OidcAuthorizeResponse, error) {
+	if s.issuerUrl == "" {
+		return nil, status.Error(codes.FailedPrecondition, "OpenID Connect login isn't configured")
+	}
+
+	provider, err := s.getProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	authUrl, err := url.Parse(provider.AuthorizationEndpoint)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "invalid authorization endpoint of the provider")
+	}
+
+	login := &model.OidcLogin{}
+	var binding string
+	for _, value := range []*string{&login.State, &login.Nonce, &login.CodeVerifier, &binding} {
+		if *value, err = newOidcToken(); err != nil {
+			return nil, err
+		}
+	}
+	login.BindingHash = hashOidcBinding(binding)
+	if err = s.oidcLoginRepository.Create(ctx, login); err != nil {
+		return nil, err
+	}
+	s.cleanup(ctx)
+
+	if err = grpc.SetHeader(ctx, metadata.Pairs(common.OidcBindingMetadata, binding)); err != nil {
+		return nil, err
+	}
+
+	challenge := sha256.Sum256([]byte(login.CodeVerifier))
+	query := authUrl.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", s.clientId)
+	query.Set("redirect_uri", s.redirectUrl)
+	query.Set("scope", strings.Join(append([]string{"openid"}, s.scopes...), " "))
+	query.Set("state", login.State)
+	query.Set("nonce", login.Nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+	authUrl.RawQuery = query.Encode()
+
+	return &user_service.OidcAuthorizeResponse{Url: authUrl.String()}, nil
+}
+
+// Callback exchanges the code for the user's ID token and starts a session for the user it belongs to.
+// The login must have been started in the browser of the request.
+func (s *OidcService) Callback(ctx context.Context, request *user_service.OidcCallbackRequest) (*user_service.LoginResponse, error) {
+	if s.issuerUrl == "" {
+		return nil, status.Error(codes.FailedPrecondition, "OpenID Connect login isn't configured")
+	}
+	if request.Error != "" {
+		return nil, status.Errorf(codes.Unauthenticated, "login failed: %s", strings.TrimSpace(request.Error+" "+request.ErrorDescription))
+	}
+	if request.Code == "" || request.State == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide code and state")
+	}
+
+	login, err := s.oidcLoginRepository.Take(ctx, request.State, time.Now().Add(-oidcLoginLifetime))
+	if err == pgx.ErrNoRows {
+		return nil, status.Error(codes.Unauthenticated, "unknown or expired login")
+	} else if err != nil {
+		return nil, err
+	}
+	// the binding is cleared as the login was taken, whether or not it completes
+	if err = grpc.SetHeader(ctx, metadata.Pairs(common.OidcBindingMetadata, "")); err != nil {
+		return nil, err
+	}
+	binding := oidcBinding(ctx)
+	if binding == "" || subtle.ConstantTimeCompare([]byte(hashOidcBinding(binding)), []byte(login.BindingHash)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "login was started in another browser")
+	}
+
+	provider, err := s.getProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := s.exchange(ctx, provider, request.Code, login.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := s.verify(ctx, provider, idToken, login.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.provision(ctx, provider.Issuer, claims)
+	if err != nil {
+		// status errors deny the login, while others are failures to complete it
+		if _, denied := status.FromError(err); denied {
+			username, _ := claims[s.usernameClaim].(string)
+			s.loginGuard.Record(ctx, username, model.LoginFailed)
+		}
+		return nil, err
+	}
+
+	if user.TotpEnabled {
+		mfaToken, err := s.tokenService.IssueMfa(uuidOf(user))
+		if err != nil {
+			return nil, err
+		}
+		s.loginGuard.Record(ctx, user.Username, model.LoginPassed)
+		return &user_service.LoginResponse{MfaRequired: true, MfaToken: mfaToken}, nil
+	}
+
+	tokens, err := s.sessionService.Start(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	s.loginGuard.Record(ctx, user.Username, model.LoginSucceeded)
+
+	return &user_service.LoginResponse{
+		Token:        tokens.Token,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt,
+
+		TotpEnrollmentRequired: s.sessionService.TotpEnrollmentRequired(user),
+	}, nil
+}
+
+// provision returns the user of the ID token's subject, creating it on the first login. Local users
+// with the same username aren't linked, as anyone able to choose the username at the provider could
+// take them over.
+func (s *OidcService) provision(ctx context.Context, issuer string, claims jwt.MapClaims) (*model.User, error) {
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, status.Error(codes.Unauthenticated, "id token has no subject")
+	}
+
+	groups := stringsClaim(claims[s.groupsClaim])
+	if len(s.allowedGroups) > 0 && !containsAny(groups, s.allowedGroups) {
+		return nil, status.Error(codes.PermissionDenied, "user isn't in a group allowed to log in")
+	}
+	role := model.Default
+	if containsAny(groups, s.adminGroups) {
+		role = model.Admin
+	}
+
+	identity, err := s.identityRepository.Get(ctx, issuer, subject)
+	if err == pgx.ErrNoRows {
+		return s.create(ctx, issuer, subject, claims, role)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var userUuid string
+	_ = identity.UserUuid.AssignTo(&userUuid)
+	user, err := s.userRepository.GetByUuid(ctx, userUuid)
+	if err == pgx.ErrNoRows {
+		return nil, status.Error(codes.PermissionDenied, "user was deleted")
+	} else if err != nil {
+		return nil, err
+	}
+	if err = s.identityRepository.UpdateLastLogin(ctx, identity.ID); err != nil {
+		return nil, err
+	}
+
+	// roles are only managed with the provider's groups when admin groups are configured. A changed
+	// role revokes the user's sessions, whose access tokens still carry the old one, while refreshed
+	// tokens and API keys use the stored role.
+	if len(s.adminGroups) > 0 && user.PrivateRole != role {
+		user.PrivateRole = role
+		if err = s.userRepository.Update(ctx, user); err != nil {
+			return nil, err
+		}
+		if err = s.sessionService.RevokeAll(ctx, userUuid, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// create creates the user with a random password, so they can only log in with the provider
+func (s *OidcService) create(
+	ctx context.Context,
+	issuer string,
+	subject string,
+	claims jwt.MapClaims,
+	role model.PrivateRole) (*model.User, error) {
+	username, _ := claims[s.usernameClaim].(string)
+	if username == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "id token has no %s claim", s.usernameClaim)
+	}
+	_, err := s.userRepository.GetByUsername(ctx, username)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "username is taken by a local user")
+	} else if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	password, err := s.passwordPolicy.Generate()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), 10)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &model.User{
+		PrivateRole: role,
+		Username:    username,
+		Password:    string(hashedPassword),
+	}
+	u, _ := uuid.NewV4()
+	user.Uuid.Set(u.Bytes())
+	identity := &model.Identity{UserUuid: user.Uuid, Issuer: issuer, Subject: subject}
+	if err = s.identityRepository.CreateWithUser(ctx, user, identity); err != nil {
+		return nil, err
+	}
+
+	return s.userRepository.GetByUuid(ctx, u.String())
+}
+
+// exchange redeems the code at the provider's token endpoint and returns the ID token
+func (s *OidcService) exchange(ctx context.Context, provider *oidcProvider, code string, codeVerifier string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", s.redirectUrl)
+	form.Set("client_id", s.clientId)
+	form.Set("code_verifier", codeVerifier)
+	if s.clientSecret != "" {
+		form.Set("client_secret", s.clientSecret)
+	}
+
+	request, _ := http.NewRequestWithContext(ctx, http.MethodPost, provider.TokenEndpoint, strings.NewReader(form.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	res, err := s.httpClient.Do(request)
+	if err != nil {
+		return "", status.Errorf(codes.Unavailable, "failed to reach the provider: %v", err)
+	}
+	defer res.Body.Close()
+
+	var body struct {
+		IdToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return "", status.Errorf(codes.Unavailable, "provider responded with %s", res.Status)
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", status.Errorf(codes.Unauthenticated, "provider rejected the code: %s", strings.TrimSpace(body.Error+" "+body.ErrorDescription))
+	}
+	if body.IdToken == "" {
+		return "", status.Error(codes.Unauthenticated, "provider returned no id token")
+	}
+
+	return body.IdToken, nil
+}
+
+// verify checks the signature, issuer, audience, expiry and nonce of the ID token and returns its claims
+func (s *OidcService) verify(ctx context.Context, provider *oidcProvider, idToken string, nonce string) (jwt.MapClaims, error) {
+	parser := &jwt.Parser{ValidMethods: oidcSigningMethods}
+	token, err := parser.Parse(idToken, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return s.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid id token: %v", err)
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+	if !claims.VerifyIssuer(provider.Issuer, true) {
+		return nil, status.Error(codes.Unauthenticated, "invalid id token issuer")
+	}
+	if !containsAny(stringsClaim(claims["aud"]), []string{s.clientId}) {
+		return nil, status.Error(codes.Unauthenticated, "invalid id token audience")
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, status.Error(codes.Unauthenticated, "id token has no expiry")
+	}
+	if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+		return nil, status.Error(codes.Unauthenticated, "invalid id token nonce")
+	}
+
+	return claims, nil
+}
+
+// getProvider returns the provider's discovery document, fetching it when it is missing or stale.
+// It is fetched without holding the lock, so a slow provider doesn't block logins which don't need it.
+func (s *OidcService) getProvider(ctx context.Context) (*oidcProvider, error) {
+	s.mu.Lock()
+	provider, fetchedAt := s.provider, s.fetchedAt
+	s.mu.Unlock()
+	if provider != nil && time.Since(fetchedAt) < oidcProviderMaxAge {
+		return provider, nil
+	}
+
+	provider = &oidcProvider{}
+	err := s.getJson(ctx, strings.TrimSuffix(s.issuerUrl, "/")+"/.well-known/openid-configuration", provider)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(provider.Issuer, "/") != strings.TrimSuffix(s.issuerUrl, "/") {
+		return nil, status.Errorf(codes.Unavailable, "provider issuer %s doesn't match the configured one", provider.Issuer)
+	}
+
+	keys, err := s.getKeys(ctx, provider.JwksUri)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.provider = provider
+	s.keys = keys
+	s.fetchedAt = time.Now()
+
+	return provider, nil
+}
+
+// key returns the provider's key of the kid, fetching the keys again if it isn't known, as the
+// provider may have rotated them. Tokens without a kid are accepted when the provider has one key.
+// The keys are fetched at most once a minute, by the first request which doesn't know its kid.
+func (s *OidcService) key(ctx context.Context, kid string) (interface{}, error) {
+	s.mu.Lock()
+	if key, ok := s.lookupKey(kid); ok {
+		s.mu.Unlock()
+		return key, nil
+	}
+	if s.provider == nil || time.Since(s.fetchedAt) < time.Minute {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("unknown key %s", kid)
+	}
+	jwksUri := s.provider.JwksUri
+	s.fetchedAt = time.Now()
+	s.mu.Unlock()
+
+	keys, err := s.getKeys(ctx, jwksUri)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	if key, ok := s.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key %s", kid)
+}
+
+func (s *OidcService) lookupKey(kid string) (interface{}, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// getKeys fetches the provider's signing keys. Keys of unsupported types are skipped.
+func (s *OidcService) getKeys(ctx context.Context, jwksUri string) (map[string]interface{}, error) {
+	var jwks struct {
+		Keys []oidcJwk `json:"keys"`
+	}
+	if err := s.getJson(ctx, jwksUri, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]interface{})
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseOidcJwk(jwk)
+		if err != nil {
+			grpclog.Warningf("[OIDC] Skipping key %s of the provider: %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func (s *OidcService) getJson(ctx context.Context, url string, result interface{}) error {
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	res, err := s.httpClient.Do(request)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to reach the provider: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return status.Errorf(codes.Unavailable, "provider responded with %s", res.Status)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, result)
+}
+
+// cleanup removes the logins which were never completed at most once an hour
+func (s *OidcService) cleanup(ctx context.Context) {
+	s.mu.Lock()
+	if time.Since(s.lastCleanup) < time.Hour {
+		s.mu.Unlock()
+		return
+	}
+	s.lastCleanup = time.Now()
+	s.mu.Unlock()
+
+	err := s.oidcLoginRepository.DeleteBefore(ctx, time.Now().Add(-oidcLoginLifetime))
+	if err != nil {
+		grpclog.Errorf("[OIDC] Failed to clean up logins: %v", err)
+	}
+}
+
+func parseOidcJwk(jwk oidcJwk) (interface{}, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %s", jwk.Kty)
+}
+
+// newOidcToken returns a random value for the state, nonce or code verifier of a login
+func newOidcToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// oidcBinding returns the value of the login binding cookie of the request, as forwarded by the HTTP gateway
+func oidcBinding(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	request := &http.Request{Header: http.Header{"Cookie": md.Get("grpcgateway-cookie")}}
+	cookie, err := request.Cookie(common.OidcBindingCookie)
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
+}
+
+func hashOidcBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
+
+// stringsClaim returns the values of a claim which is either a string or an array of strings
+func stringsClaim(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var values []string
+		for _, value := range v {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+
+	return nil
+}
+
+func containsAny(values []string, wanted []string) bool {
+	for _, value := range values {
+		for _, w := range wanted {
+			if value == w {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,531 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testOidcClientId     = "analysis-web"
+	testOidcClientSecret = "client-secret"
+	testOidcRedirectUrl  = "https://analysis.example/api/v1/users/oidc/callback"
+	testOidcKid          = "idp-key"
+	testOidcAdminGroup   = "analysis-admins"
+)
+
+// mockIdp is an OpenID Connect provider which issues codes for the logins the test grants
+type mockIdp struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu     sync.Mutex
+	grants map[string]mockIdpGrant
+}
+
+// mockIdpGrant is a code issued for a login, with the PKCE challenge and the nonce the login
+// was started with and the claims of the ID token it is redeemed for
+type mockIdpGrant struct {
+	challenge string
+	nonce     string
+	claims    jwt.MapClaims
+}
+
+// the providers of the tests share a key, as generating one takes a while
+var (
+	mockIdpKey     *rsa.PrivateKey
+	mockIdpKeyErr  error
+	mockIdpKeyOnce sync.Once
+)
+
+func newMockIdp(t *testing.T) *mockIdp {
+	mockIdpKeyOnce.Do(func() {
+		mockIdpKey, mockIdpKeyErr = rsa.GenerateKey(rand.Reader, 2048)
+	})
+	if mockIdpKeyErr != nil {
+		t.Fatal(mockIdpKeyErr)
+	}
+	key := mockIdpKey
+	idp := &mockIdp{key: key, grants: make(map[string]mockIdpGrant)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidcProvider{
+			Issuer:                idp.URL,
+			AuthorizationEndpoint: idp.URL + "/authorize",
+			TokenEndpoint:         idp.URL + "/token",
+			JwksUri:               idp.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string][]oidcJwk{"keys": {{
+			Kty: "RSA",
+			Kid: testOidcKid,
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", idp.token)
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+
+	return idp
+}
+
+// token redeems a code of the client once, if the verifier matches the challenge of its login
+func (idp *mockIdp) token(w http.ResponseWriter, r *http.Request) {
+	code := r.PostFormValue("code")
+	idp.mu.Lock()
+	grant, ok := idp.grants[code]
+	delete(idp.grants, code)
+	idp.mu.Unlock()
+
+	challenge := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+	if r.Method != http.MethodPost ||
+		!ok ||
+		r.PostFormValue("grant_type") != "authorization_code" ||
+		r.PostFormValue("client_id") != testOidcClientId ||
+		r.PostFormValue("client_secret") != testOidcClientSecret ||
+		r.PostFormValue("redirect_uri") != testOidcRedirectUrl ||
+		base64.RawURLEncoding.EncodeToString(challenge[:]) != grant.challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   idp.URL,
+		"aud":   testOidcClientId,
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"nonce": grant.nonce,
+	}
+	for k, v := range grant.claims {
+		claims[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testOidcKid
+	idToken, err := token.SignedString(idp.key)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]string{"id_token": idToken})
+}
+
+// grant logs the user in at the authorization url and returns the state and the code the provider
+// redirects back with. The ID token the code is redeemed for carries the claims.
+func (idp *mockIdp) grant(t *testing.T, authorizationUrl string, claims jwt.MapClaims) (string, string) {
+	u, err := url.Parse(authorizationUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if !strings.HasPrefix(authorizationUrl, idp.URL+"/authorize?") ||
+		query.Get("response_type") != "code" ||
+		query.Get("client_id") != testOidcClientId ||
+		query.Get("redirect_uri") != testOidcRedirectUrl ||
+		!strings.HasPrefix(query.Get("scope"), "openid ") ||
+		query.Get("code_challenge_method") != "S256" ||
+		query.Get("code_challenge") == "" ||
+		query.Get("state") == "" ||
+		query.Get("nonce") == "" {
+		t.Fatalf("invalid authorization url %s", authorizationUrl)
+	}
+
+	code, err := newOidcToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.grants[code] = mockIdpGrant{challenge: query.Get("code_challenge"), nonce: query.Get("nonce"), claims: claims}
+
+	return query.Get("state"), code
+}
+
+// fakeOidcLoginRepository keeps the pending logins by state
+type fakeOidcLoginRepository struct {
+	repo.OidcLoginRepositoryContract
+	logins map[string]*model.OidcLogin
+}
+
+func (r *fakeOidcLoginRepository) Create(_ context.Context, login *model.OidcLogin) error {
+	stored := *login
+	r.logins[login.State] = &stored
+	return nil
+}
+
+func (r *fakeOidcLoginRepository) Take(_ context.Context, state string, _ time.Time) (*model.OidcLogin, error) {
+	login, ok := r.logins[state]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	delete(r.logins, state)
+	return login, nil
+}
+
+func (r *fakeOidcLoginRepository) DeleteBefore(_ context.Context, _ time.Time) error {
+	return nil
+}
+
+// fakeIdentityRepository keeps the identities, and creates their users in the user repository
+type fakeIdentityRepository struct {
+	repo.IdentityRepositoryContract
+	users      *fakeUserRepository
+	identities []model.Identity
+}
+
+func (r *fakeIdentityRepository) Get(_ context.Context, issuer string, subject string) (*model.Identity, error) {
+	for _, identity := range r.identities {
+		if identity.Issuer == issuer && identity.Subject == subject {
+			stored := identity
+			return &stored, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (r *fakeIdentityRepository) CreateWithUser(ctx context.Context, user *model.User, identity *model.Identity) error {
+	identity.ID = uint(len(r.identities) + 1)
+	r.identities = append(r.identities, *identity)
+	return r.users.Update(ctx, user)
+}
+
+func (r *fakeIdentityRepository) UpdateLastLogin(_ context.Context, _ uint) error {
+	return nil
+}
+
+// fakeSessionRepository keeps the sessions
+type fakeSessionRepository struct {
+	repo.SessionRepositoryContract
+	sessions []model.Session
+}
+
+func (r *fakeSessionRepository) Create(_ context.Context, session *model.Session) error {
+	r.sessions = append(r.sessions, *session)
+	return nil
+}
+
+func (r *fakeSessionRepository) RevokeAll(_ context.Context, userUuid string, exceptUuid string) ([]string, error) {
+	var revoked []string
+	for i := range r.sessions {
+		var u, sessionUuid string
+		_ = r.sessions[i].UserUuid.AssignTo(&u)
+		_ = r.sessions[i].Uuid.AssignTo(&sessionUuid)
+		if u != userUuid || sessionUuid == exceptUuid || r.sessions[i].RevokedAt.Status == pgtype.Present {
+			continue
+		}
+		r.sessions[i].RevokedAt = pgtype.Timestamptz{Time: time.Now(), Status: pgtype.Present}
+		revoked = append(revoked, sessionUuid)
+	}
+	return revoked, nil
+}
+
+// fakeServerTransportStream keeps the headers the service sets, which the HTTP gateway turns into cookies
+type fakeServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *fakeServerTransportStream) Method() string {
+	return "/user_service.UserService/OidcCallback"
+}
+
+func (s *fakeServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *fakeServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *fakeServerTransportStream) SetTrailer(_ metadata.MD) error {
+	return nil
+}
+
+// testOidc is an OidcService logging users in with the mock provider, with the fakes it stores to
+type testOidc struct {
+	service  *OidcService
+	idp      *mockIdp
+	users    *fakeUserRepository
+	logins   *fakeOidcLoginRepository
+	sessions *fakeSessionRepository
+	attempts *fakeLoginAttemptRepository
+}
+
+func newTestOidc(t *testing.T, allowedGroups []string) *testOidc {
+	tokenService, _ := newTestTokenService(t, time.Time{})
+	o := &testOidc{
+		idp:      newMockIdp(t),
+		users:    &fakeUserRepository{users: make(map[string]*model.User)},
+		logins:   &fakeOidcLoginRepository{logins: make(map[string]*model.OidcLogin)},
+		sessions: &fakeSessionRepository{},
+		attempts: &fakeLoginAttemptRepository{},
+	}
+	config := &common.Config{
+		OidcIssuerUrl:     o.idp.URL,
+		OidcClientId:      testOidcClientId,
+		OidcClientSecret:  testOidcClientSecret,
+		OidcRedirectUrl:   testOidcRedirectUrl,
+		OidcAdminGroups:   []string{testOidcAdminGroup},
+		OidcAllowedGroups: allowedGroups,
+	}
+	passwordPolicy, err := NewPasswordPolicy(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o.service = NewOidcService(
+		o.users,
+		&fakeIdentityRepository{users: o.users},
+		o.logins,
+		tokenService,
+		NewSessionService(o.sessions, o.users, tokenService, config),
+		NewLoginGuard(o.attempts, config),
+		passwordPolicy,
+		config)
+	return o
+}
+
+// browserContext is the context of a call from a browser with the cookie header, which may be empty
+func browserContext(cookie string) (context.Context, *fakeServerTransportStream) {
+	ctx := testClientContext(testIpAddress)
+	if cookie != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("grpcgateway-cookie", cookie))
+	}
+	stream := &fakeServerTransportStream{}
+	return grpc.NewContextWithServerTransportStream(ctx, stream), stream
+}
+
+// start starts a login in a browser and grants it at the provider with the claims. It returns the
+// callback request the provider redirects the browser to and the browser's binding cookie.
+func (o *testOidc) start(t *testing.T, claims jwt.MapClaims) (*user_service.OidcCallbackRequest, string) {
+	ctx, stream := browserContext("")
+	res, err := o.service.Authorize(ctx, &user_service.OidcAuthorizeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	binding := stream.header.Get(common.OidcBindingMetadata)
+	if len(binding) != 1 || binding[0] == "" {
+		t.Fatalf("login binding %v, want one", binding)
+	}
+
+	state, code := o.idp.grant(t, res.Url, claims)
+	return &user_service.OidcCallbackRequest{Code: code, State: state}, binding[0]
+}
+
+// callback completes the login in the browser with the binding cookie, which may be empty
+func (o *testOidc) callback(request *user_service.OidcCallbackRequest, binding string) (*user_service.LoginResponse, error) {
+	cookie := ""
+	if binding != "" {
+		cookie = "theme=dark; " + common.OidcBindingCookie + "=" + binding
+	}
+	ctx, stream := browserContext(cookie)
+	res, err := o.service.Callback(ctx, request)
+
+	// the binding is cleared once the login was taken
+	if cleared := stream.header.Get(common.OidcBindingMetadata); err == nil && (len(cleared) != 1 || cleared[0] != "") {
+		return nil, status.Errorf(codes.Internal, "binding set to %v, want it cleared", cleared)
+	}
+	return res, err
+}
+
+// login logs the user with the claims in and returns the role of the access token
+func (o *testOidc) login(t *testing.T, claims jwt.MapClaims) model.PrivateRole {
+	request, binding := o.start(t, claims)
+	res, err := o.callback(request, binding)
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	tokenClaims, err := o.service.tokenService.Validate(context.Background(), res.Token)
+	if err != nil {
+		t.Fatalf("invalid access token: %v", err)
+	}
+	return tokenClaims.PrivateRole
+}
+
+func testOidcClaims(subject string, groups ...string) jwt.MapClaims {
+	g := make([]interface{}, len(groups))
+	for i, group := range groups {
+		g[i] = group
+	}
+	return jwt.MapClaims{"sub": subject, "preferred_username": "oidc-" + subject, "groups": g}
+}
+
+func TestOidcLogin(t *testing.T) {
+	tests := []struct {
+		name          string
+		allowedGroups []string
+		claims        jwt.MapClaims
+		// modify changes the callback of the browser
+		modify func(o *testOidc, request *user_service.OidcCallbackRequest, binding *string)
+		code   codes.Code
+		role   model.PrivateRole
+		// result is the recorded login attempt, if any
+		result string
+	}{
+		{"user", nil, testOidcClaims("alice", "analysts"), nil, codes.OK, model.Default, model.LoginSucceeded},
+		{"admin group", nil, testOidcClaims("alice", "analysts", testOidcAdminGroup), nil, codes.OK, model.Admin, model.LoginSucceeded},
+		{"allowed group", []string{"analysts"}, testOidcClaims("alice", "analysts"), nil, codes.OK, model.Default, model.LoginSucceeded},
+		{"no allowed group", []string{"analysts"}, testOidcClaims("alice", testOidcAdminGroup), nil, codes.PermissionDenied, 0, model.LoginFailed},
+		{"username of a local user", nil, testOidcClaims("alice"),
+			func(o *testOidc, _ *user_service.OidcCallbackRequest, _ *string) {
+				local := &model.User{Username: "oidc-alice"}
+				_ = local.Uuid.Set(testUserUuid)
+				o.users.users[testUserUuid] = local
+			}, codes.AlreadyExists, 0, model.LoginFailed},
+		{"no subject", nil, jwt.MapClaims{"preferred_username": "oidc-alice"}, nil, codes.Unauthenticated, 0, model.LoginFailed},
+		{"provider error", nil, testOidcClaims("alice"),
+			func(_ *testOidc, request *user_service.OidcCallbackRequest, _ *string) {
+				request.Error = "access_denied"
+			}, codes.Unauthenticated, 0, ""},
+		{"unknown state", nil, testOidcClaims("alice"),
+			func(_ *testOidc, request *user_service.OidcCallbackRequest, _ *string) {
+				request.State = "unknown"
+			}, codes.Unauthenticated, 0, ""},
+		{"no binding cookie", nil, testOidcClaims("alice"),
+			func(_ *testOidc, _ *user_service.OidcCallbackRequest, binding *string) {
+				*binding = ""
+			}, codes.Unauthenticated, 0, ""},
+		{"binding cookie of another browser", nil, testOidcClaims("alice"),
+			func(_ *testOidc, _ *user_service.OidcCallbackRequest, binding *string) {
+				*binding = "other"
+			}, codes.Unauthenticated, 0, ""},
+		{"unknown code", nil, testOidcClaims("alice"),
+			func(_ *testOidc, request *user_service.OidcCallbackRequest, _ *string) {
+				request.Code = "unknown"
+			}, codes.Unauthenticated, 0, ""},
+		{"other code verifier", nil, testOidcClaims("alice"),
+			func(o *testOidc, request *user_service.OidcCallbackRequest, _ *string) {
+				o.logins.logins[request.State].CodeVerifier = "other"
+			}, codes.Unauthenticated, 0, ""},
+		{"other nonce", nil, jwt.MapClaims{"sub": "alice", "preferred_username": "oidc-alice", "nonce": "other"}, nil, codes.Unauthenticated, 0, ""},
+		{"other audience", nil, jwt.MapClaims{"sub": "alice", "preferred_username": "oidc-alice", "aud": "other"}, nil, codes.Unauthenticated, 0, ""},
+		{"other issuer", nil, jwt.MapClaims{"sub": "alice", "preferred_username": "oidc-alice", "iss": "https://other.example"}, nil, codes.Unauthenticated, 0, ""},
+		{"expired", nil, jwt.MapClaims{"sub": "alice", "preferred_username": "oidc-alice", "exp": time.Now().Add(-time.Minute).Unix()}, nil, codes.Unauthenticated, 0, ""},
+	}
+
+	for _, test := range tests {
+		o := newTestOidc(t, test.allowedGroups)
+		request, binding := o.start(t, test.claims)
+		if test.modify != nil {
+			test.modify(o, request, &binding)
+		}
+
+		res, err := o.callback(request, binding)
+		if status.Code(err) != test.code {
+			t.Errorf("%s: got %v, want %v", test.name, err, test.code)
+			continue
+		}
+		if test.result == "" && len(o.attempts.attempts) != 0 {
+			t.Errorf("%s: recorded %d login attempts, want none", test.name, len(o.attempts.attempts))
+		}
+		if test.result != "" && (len(o.attempts.attempts) != 1 || o.attempts.attempts[0].Result != test.result ||
+			o.attempts.attempts[0].Username != "oidc-alice" || o.attempts.attempts[0].IpAddress != testIpAddress) {
+			t.Errorf("%s: recorded login attempts %v, want %s of oidc-alice", test.name, o.attempts.attempts, test.result)
+		}
+		if err != nil {
+			continue
+		}
+
+		claims, err := o.service.tokenService.Validate(context.Background(), res.Token)
+		if err != nil {
+			t.Errorf("%s: invalid access token: %v", test.name, err)
+			continue
+		}
+		user, err := o.users.GetByUuid(context.Background(), claims.Uuid)
+		if err != nil || user.Username != "oidc-alice" || user.PrivateRole != test.role || claims.PrivateRole != test.role {
+			t.Errorf("%s: logged in as %v with role %v, want oidc-alice with role %v", test.name, user, claims.PrivateRole, test.role)
+		}
+		if len(o.sessions.sessions) != 1 || res.RefreshToken == "" {
+			t.Errorf("%s: started %d sessions, want one", test.name, len(o.sessions.sessions))
+		}
+	}
+}
+
+func TestOidcLoginCompletesOnce(t *testing.T) {
+	o := newTestOidc(t, nil)
+	request, binding := o.start(t, testOidcClaims("alice"))
+	if _, err := o.callback(request, binding); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.callback(request, binding); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("completed login was completed again: %v", err)
+	}
+}
+
+func TestOidcLoginFollowsGroups(t *testing.T) {
+	o := newTestOidc(t, nil)
+
+	if role := o.login(t, testOidcClaims("alice")); role != model.Default {
+		t.Fatalf("first login with role %v, want %v", role, model.Default)
+	}
+	// the role changed at the provider applies on the next login, and revokes the user's sessions
+	if role := o.login(t, testOidcClaims("alice", testOidcAdminGroup)); role != model.Admin {
+		t.Fatalf("login in admin group with role %v, want %v", role, model.Admin)
+	}
+	if len(o.users.users) != 1 {
+		t.Fatalf("logins created %d users, want one", len(o.users.users))
+	}
+	if len(o.sessions.sessions) != 2 || o.sessions.sessions[0].RevokedAt.Status != pgtype.Present ||
+		o.sessions.sessions[1].RevokedAt.Status == pgtype.Present {
+		t.Error("session of the previous role wasn't revoked")
+	}
+	if role := o.login(t, testOidcClaims("alice", testOidcAdminGroup)); role != model.Admin {
+		t.Fatalf("second login in admin group with role %v, want %v", role, model.Admin)
+	}
+	if o.sessions.sessions[1].RevokedAt.Status == pgtype.Present {
+		t.Error("session was revoked though the role didn't change")
+	}
+	if role := o.login(t, testOidcClaims("alice")); role != model.Default {
+		t.Fatalf("login out of admin group with role %v, want %v", role, model.Default)
+	}
+}
+
+func TestOidcLoginRequiresSecondFactor(t *testing.T) {
+	o := newTestOidc(t, nil)
+	o.login(t, testOidcClaims("alice"))
+	for _, user := range o.users.users {
+		user.TotpEnabled = true
+	}
+
+	request, binding := o.start(t, testOidcClaims("alice"))
+	res, err := o.callback(request, binding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.MfaRequired || res.MfaToken == "" || res.Token != "" || res.RefreshToken != "" {
+		t.Errorf("got %v, want an mfa token", res)
+	}
+	if _, err = o.service.tokenService.ValidateMfa(context.Background(), res.MfaToken); err != nil {
+		t.Errorf("invalid mfa token: %v", err)
+	}
+	if last := o.attempts.attempts[len(o.attempts.attempts)-1]; last.Result != model.LoginPassed {
+		t.Errorf("recorded %s login, want %s", last.Result, model.LoginPassed)
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPasswordMinLength = 8
+	// bcrypt ignores everything after 72 bytes
+	passwordMaxLength       = 72
+	generatedPasswordLength = 20
+)
+
+// character classes of generated passwords, without characters which are easily confused
+var passwordClasses = []string{
+	"abcdefghijkmnopqrstuvwxyz",
+	"ABCDEFGHJKLMNPQRSTUVWXYZ",
+	"23456789",
+	"!#$%&*+-=?@^_",
+}
+
+// PasswordPolicy validates chosen passwords and generates ones which satisfy it
+type PasswordPolicy struct {
+	minLength  int
+	minClasses int
+	// breached is the file of the sorted SHA-1 hashes of known breached passwords, or nil
+	breached     *os.File
+	breachedSize int64
+}
+
+// NewPasswordPolicy creates the policy of the config, opening the breached passwords file if one is set.
+// The file holds the hex SHA-1 hash of a password per line in ascending order, optionally followed by
+// a colon and a count, as in the Have I Been Pwned lists ordered by hash. It is binary searched on
+// every validation, so it isn't loaded into memory.
+func NewPasswordPolicy(config *common.Config) (*PasswordPolicy, error) {
+	p := &PasswordPolicy{
+		minLength:  config.PasswordMinLength,
+		minClasses: config.PasswordMinClasses,
+	}
+	if p.minLength <= 0 {
+		p.minLength = defaultPasswordMinLength
+	}
+	if p.minLength > passwordMaxLength {
+		return nil, fmt.Errorf("minimum password length can't exceed %d", passwordMaxLength)
+	}
+	if p.minClasses > len(passwordClasses) {
+		return nil, fmt.Errorf("passwords can have at most %d character classes", len(passwordClasses))
+	}
+
+	if config.BreachedPasswordsFile == "" {
+		return p, nil
+	}
+	f, err := os.Open(config.BreachedPasswordsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breached passwords: %v", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to open breached passwords: %v", err)
+	}
+	p.breached = f
+	p.breachedSize = info.Size()
+
+	// catch files of passwords rather than hashes, which can't be searched
+	if p.breachedSize > 0 {
+		_, first, err := p.lineAt(0)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to read breached passwords: %v", err)
+		}
+		if !isSha1Hex(breachedHash(first)) {
+			f.Close()
+			return nil, errors.New("breached passwords must be sorted SHA-1 hashes")
+		}
+	}
+
+	return p, nil
+}
+
+// Validate returns an InvalidArgument error describing why the password doesn't satisfy the policy
+func (p *PasswordPolicy) Validate(password string, username string) error {
+	if len(password) < p.minLength {
+		return status.Errorf(codes.InvalidArgument, "Minimum password length is %d.", p.minLength)
+	}
+	if len(password) > passwordMaxLength {
+		return status.Errorf(codes.InvalidArgument, "Maximum password length is %d bytes.", passwordMaxLength)
+	}
+	if classes := characterClasses(password); classes < p.minClasses {
+		return status.Errorf(codes.InvalidArgument,
+			"Password must contain %d of lower case letters, upper case letters, digits and symbols.", p.minClasses)
+	}
+	if username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		return status.Error(codes.InvalidArgument, "Password must not contain the username.")
+	}
+	breached, err := p.isBreached(sha1Hex(password))
+	if err != nil {
+		return err
+	}
+	if breached {
+		return status.Error(codes.InvalidArgument, "Password is known from a data breach, choose another one.")
+	}
+
+	return nil
+}
+
+// isBreached binary searches the breached passwords file for the hash. The search narrows the byte
+// range lines may start in, comparing the first line starting at or after the middle of it.
+func (p *PasswordPolicy) isBreached(hash string) (bool, error) {
+	if p.breached == nil {
+		return false, nil
+	}
+
+	lo, hi := int64(0), p.breachedSize
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		start, line, err := p.lineAt(mid)
+		if err != nil {
+			return false, err
+		}
+		if start >= hi {
+			hi = mid
+			continue
+		}
+
+		switch strings.Compare(strings.ToUpper(breachedHash(line)), hash) {
+		case 0:
+			return true, nil
+		case -1:
+			lo = start + int64(len(line))
+		default:
+			hi = mid
+		}
+	}
+
+	return false, nil
+}
+
+// lineAt returns the offset and the content, with its line break, of the first line of the breached
+// passwords file starting at or after offset. The offset is the file's size if there is none.
+func (p *PasswordPolicy) lineAt(offset int64) (int64, string, error) {
+	start := offset
+	if offset > 0 {
+		// a line starts at the offset if the byte before it ends the previous line
+		start = offset - 1
+	}
+	r := bufio.NewReader(io.NewSectionReader(p.breached, start, p.breachedSize-start))
+	if offset > 0 {
+		skipped, err := r.ReadString('\n')
+		if err == io.EOF {
+			return p.breachedSize, "", nil
+		} else if err != nil {
+			return 0, "", err
+		}
+		start += int64(len(skipped))
+	}
+
+	line, err := r.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return 0, "", err
+	}
+	if line == "" {
+		return p.breachedSize, "", nil
+	}
+
+	return start, line, nil
+}
+
+// breachedHash returns the hash of a line of the breached passwords file
+func breachedHash(line string) string {
+	return strings.TrimSpace(strings.SplitN(line, ":", 2)[0])
+}
+
+// Generate returns a random password from crypto/rand with a character of every class
+func (p *PasswordPolicy) Generate() (string, error) {
+	length := generatedPasswordLength
+	if p.minLength > length {
+		length = p.minLength
+	}
+
+	all := strings.Join(passwordClasses, "")
+	password := make([]byte, length)
+	for i := range password {
+		charset := all
+		// the first characters cover every class, and are shuffled below
+		if i < len(passwordClasses) {
+			charset = passwordClasses[i]
+		}
+		c, err := randomIndex(len(charset))
+		if err != nil {
+			return "", err
+		}
+		password[i] = charset[c]
+	}
+
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// characterClasses counts the classes of lower case letters, upper case letters, digits and symbols in the password
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+
+	return lower + upper + digit + symbol
+}
+
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(i.Int64()), nil
+}
+
+func sha1Hex(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func isSha1Hex(s string) bool {
+	if len(s) != 2*sha1.Size {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+)
+
+// newTestPasswordPolicy creates a policy with a breached passwords file of the content
+func newTestPasswordPolicy(t *testing.T, content string) (*PasswordPolicy, error) {
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewPasswordPolicy(&common.Config{BreachedPasswordsFile: path})
+	if p != nil {
+		t.Cleanup(func() { p.breached.Close() })
+	}
+	return p, err
+}
+
+func TestLineAt(t *testing.T) {
+	// lines start at 0, 4, 9 and 11
+	const content = "AAA\nBBBB\nC\nDD"
+	path := filepath.Join(t.TempDir(), "lines.txt")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	p := &PasswordPolicy{breached: f, breachedSize: int64(len(content))}
+
+	tests := []struct {
+		offset int64
+		start  int64
+		line   string
+	}{
+		{0, 0, "AAA\n"},
+		{1, 4, "BBBB\n"},
+		{3, 4, "BBBB\n"},
+		{4, 4, "BBBB\n"},
+		{5, 9, "C\n"},
+		{9, 9, "C\n"},
+		{10, 11, "DD"},
+		{11, 11, "DD"},
+		{12, int64(len(content)), ""},
+		{int64(len(content)), int64(len(content)), ""},
+	}
+
+	for _, test := range tests {
+		start, line, err := p.lineAt(test.offset)
+		if err != nil || start != test.start || line != test.line {
+			t.Errorf("line at %d = %d, %q, %v, want %d, %q", test.offset, start, line, err, test.start, test.line)
+		}
+	}
+}
+
+func TestIsBreached(t *testing.T) {
+	var hashes []string
+	for _, password := range []string{"password", "123456", "qwerty", "letmein", "dragon", "monkey", "abc123"} {
+		hashes = append(hashes, sha1Hex(password))
+	}
+	sort.Strings(hashes)
+
+	lines := func(format func(i int, hash string) string, separator string, trailing bool) string {
+		var result []string
+		for i, hash := range hashes {
+			result = append(result, format(i, hash))
+		}
+		content := strings.Join(result, separator)
+		if trailing {
+			content += separator
+		}
+		return content
+	}
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"hashes", lines(func(_ int, hash string) string { return hash }, "\n", true)},
+		{"hashes with counts", lines(func(i int, hash string) string { return hash + ":" + strings.Repeat("9", i+1) }, "\n", true)},
+		{"lower case hashes", lines(func(_ int, hash string) string { return strings.ToLower(hash) }, "\n", true)},
+		{"crlf line breaks", lines(func(_ int, hash string) string { return hash + ":1" }, "\r\n", true)},
+		{"no trailing line break", lines(func(_ int, hash string) string { return hash }, "\n", false)},
+		{"single hash", hashes[0] + "\n"},
+	}
+
+	for _, file := range files {
+		p, err := newTestPasswordPolicy(t, file.content)
+		if err != nil {
+			t.Fatalf("%s: %v", file.name, err)
+		}
+		stored := strings.Count(file.content, "\n")
+		if !strings.HasSuffix(file.content, "\n") {
+			stored++
+		}
+
+		for i, hash := range hashes {
+			breached, err := p.isBreached(hash)
+			if err != nil || breached != (i < stored) {
+				t.Errorf("%s: hash %d breached %v, %v, want %v", file.name, i, breached, err, i < stored)
+			}
+		}
+		for _, password := range []string{"correct horse battery staple", "Tr0ub4dor&3", ""} {
+			if breached, err := p.isBreached(sha1Hex(password)); err != nil || breached {
+				t.Errorf("%s: %q breached %v, %v", file.name, password, breached, err)
+			}
+		}
+		for _, hash := range []string{strings.Repeat("0", 40), strings.Repeat("F", 40)} {
+			if breached, err := p.isBreached(hash); err != nil || breached {
+				t.Errorf("%s: %s breached %v, %v", file.name, hash, breached, err)
+			}
+		}
+	}
+}
+
+func TestNewPasswordPolicyRejectsPasswords(t *testing.T) {
+	if _, err := newTestPasswordPolicy(t, "password\n123456\n"); err == nil {
+		t.Error("file of passwords was accepted")
+	}
+	if _, err := newTestPasswordPolicy(t, ""); err != nil {
+		t.Errorf("empty file: %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	validation "github.com/vectorman1/analysis/analysis-api/common/errors"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+
+	"github.com/jackc/pgx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxDisplayNameLength   = 100
+	maxPreferredIndicators = 20
+	maxIndicatorNameLength = 50
+	currencyCodeLength     = 3
+)
+
+// profileFields are the fields of the profile users can update
+var profileFields = map[string]bool{
+	"displayName":         true,
+	"email":               true,
+	"timezone":            true,
+	"defaultCurrency":     true,
+	"defaultChartRange":   true,
+	"preferredIndicators": true,
+}
+
+// ProfileService manages the profiles and preferences of users
+type ProfileService struct {
+	profileRepository *repo.ProfileRepository
+	userRepository    *repo.UserRepository
+}
+
+func NewProfileService(profileRepository *repo.ProfileRepository, userRepository *repo.UserRepository) *ProfileService {
+	return &ProfileService{
+		profileRepository: profileRepository,
+		userRepository:    userRepository,
+	}
+}
+
+// GetMe returns the profile of the request's user
+func (s *ProfileService) GetMe(ctx context.Context, _ *user_service.GetMeRequest) (*user_service.Profile, error) {
+	user, err := s.requestUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.getProfile(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	res := profile.ToProto()
+	res.Username = user.Username
+	return res, nil
+}
+
+// UpdateMe validates and stores the fields of the mask, or all fields when it is empty
+func (s *ProfileService) UpdateMe(ctx context.Context, request *user_service.UpdateMeRequest) (*user_service.Profile, error) {
+	if request.Profile == nil {
+		return nil, status.Error(codes.InvalidArgument, "provide profile")
+	}
+
+	paths := profileFields
+	if request.UpdateMask != nil && len(request.UpdateMask.Paths) > 0 {
+		paths = map[string]bool{}
+		for _, path := range request.UpdateMask.Paths {
+			if !profileFields[path] {
+				return nil, status.Errorf(codes.InvalidArgument, "can't update %s", path)
+			}
+			paths[path] = true
+		}
+	}
+
+	user, err := s.requestUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.getProfile(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	update := request.Profile
+	if paths["displayName"] {
+		profile.DisplayName = strings.TrimSpace(update.DisplayName)
+		if utf8.RuneCountInString(profile.DisplayName) > maxDisplayNameLength {
+			return nil, status.Errorf(codes.InvalidArgument, "display name can be at most %d characters", maxDisplayNameLength)
+		}
+	}
+	if paths["email"] {
+		profile.Email = strings.TrimSpace(update.Email)
+		if profile.Email != "" {
+			address, err := mail.ParseAddress(profile.Email)
+			if err != nil || address.Name != "" || address.Address != profile.Email {
+				return nil, status.Error(codes.InvalidArgument, "invalid email")
+			}
+		}
+	}
+	if paths["timezone"] {
+		profile.Timezone = update.Timezone
+		if profile.Timezone != "" {
+			if _, err := time.LoadLocation(profile.Timezone); err != nil || profile.Timezone == "Local" {
+				return nil, status.Error(codes.InvalidArgument, "unknown timezone")
+			}
+		}
+	}
+	if paths["defaultCurrency"] {
+		profile.DefaultCurrency = strings.ToUpper(update.DefaultCurrency)
+		if profile.DefaultCurrency != "" && !isCurrencyCode(profile.DefaultCurrency) {
+			return nil, status.Error(codes.InvalidArgument, "currency must be an ISO 4217 code")
+		}
+	}
+	if paths["defaultChartRange"] {
+		profile.DefaultChartRange = strings.ToUpper(update.DefaultChartRange)
+		if _, ok := common.ChartRangeStart(profile.DefaultChartRange, time.Now()); profile.DefaultChartRange != "" && !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown chart range")
+		}
+	}
+	if paths["preferredIndicators"] {
+		profile.PreferredIndicators, err = preferredIndicators(update.PreferredIndicators)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.profileRepository.Upsert(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return s.GetMe(ctx, &user_service.GetMeRequest{})
+}
+
+// ChartPreferences returns the chart range the user chose, or an empty string if there is none, and
+// the location of their timezone, which is UTC if they chose none
+func (s *ProfileService) ChartPreferences(ctx context.Context, userUuid string) (string, *time.Location, error) {
+	profile, err := s.profileRepository.GetByUser(ctx, userUuid)
+	if err == pgx.ErrNoRows {
+		return "", time.UTC, nil
+	} else if err != nil {
+		return "", nil, err
+	}
+
+	location := time.UTC
+	if profile.Timezone != "" {
+		if location, err = time.LoadLocation(profile.Timezone); err != nil {
+			location = time.UTC
+		}
+	}
+
+	return profile.DefaultChartRange, location, nil
+}
+
+func (s *ProfileService) requestUser(ctx context.Context) (*model.User, error) {
+	claims, ok := common.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "provide user token")
+	}
+
+	user, err := s.userRepository.GetByUuid(ctx, claims.Uuid)
+	if err == pgx.ErrNoRows {
+		return nil, status.Error(codes.NotFound, validation.NoUserFound)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// getProfile returns the stored profile of the user, or an empty one if the user has none yet
+func (s *ProfileService) getProfile(ctx context.Context, user *model.User) (*model.Profile, error) {
+	profile, err := s.profileRepository.GetByUser(ctx, uuidOf(user))
+	if err == pgx.ErrNoRows {
+		return &model.Profile{UserUuid: user.Uuid, PreferredIndicators: []string{}}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// preferredIndicators validates the indicators and removes blank and repeated ones
+func preferredIndicators(indicators []string) ([]string, error) {
+	res := []string{}
+	seen := map[string]bool{}
+	for _, indicator := range indicators {
+		indicator = strings.TrimSpace(indicator)
+		if indicator == "" || seen[indicator] {
+			continue
+		}
+		if utf8.RuneCountInString(indicator) > maxIndicatorNameLength {
+			return nil, status.Errorf(codes.InvalidArgument, "indicator names can be at most %d characters", maxIndicatorNameLength)
+		}
+		seen[indicator] = true
+		res = append(res, indicator)
+	}
+	if len(res) > maxPreferredIndicators {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d indicators can be preferred", maxPreferredIndicators)
+	}
+
+	return res, nil
+}
+
+func isCurrencyCode(code string) bool {
+	if len(code) != currencyCodeLength {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
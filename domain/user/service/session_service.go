@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultRefreshTokenLifetime = 30 * 24 * time.Hour
+
+// revocations made by other replicas are picked up this often
+const revocationSyncInterval = 30 * time.Second
+
+// SessionService keeps users logged in with rotating refresh tokens and tracks the revoked
+// sessions whose access tokens haven't expired yet, which the auth interceptor rejects
+type SessionService struct {
+	sessionRepository    repo.SessionRepositoryContract
+	userRepository       repo.UserRepositoryContract
+	tokenService         *TokenService
+	refreshTokenLifetime time.Duration
+	requireAdminTotp     bool
+
+	mu      sync.RWMutex
+	revoked map[string]time.Time
+}
+
+func NewSessionService(
+	sessionRepository repo.SessionRepositoryContract,
+	userRepository repo.UserRepositoryContract,
+	tokenService *TokenService,
+	config *common.Config) *SessionService {
+	lifetime := time.Duration(config.RefreshTokenDays) * 24 * time.Hour
+	if lifetime <= 0 {
+		lifetime = defaultRefreshTokenLifetime
+	}
+
+	return &SessionService{
+		sessionRepository:    sessionRepository,
+		userRepository:       userRepository,
+		tokenService:         tokenService,
+		refreshTokenLifetime: lifetime,
+		requireAdminTotp:     config.RequireAdminTotp,
+		revoked:              make(map[string]time.Time),
+	}
+}
+
+// Run syncs the revoked sessions and removes old sessions until the context is done
+func (s *SessionService) Run(ctx context.Context) {
+	ticker := time.NewTicker(revocationSyncInterval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		if err := s.syncRevoked(ctx); err != nil {
+			grpclog.Errorf("[SESSIONS] Failed to sync revoked sessions: %v", err)
+		}
+		if time.Since(lastCleanup) > time.Hour {
+			lastCleanup = time.Now()
+			if err := s.sessionRepository.DeleteBefore(ctx, time.Now().Add(-s.refreshTokenLifetime)); err != nil {
+				grpclog.Errorf("[SESSIONS] Failed to delete old sessions: %v", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsRevoked reports whether the session was revoked. Revocations made by other replicas
+// are known once they are synced.
+func (s *SessionService) IsRevoked(sessionUuid string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.revoked[sessionUuid]
+	return ok
+}
+
+// Start creates a session for the user and issues its tokens
+func (s *SessionService) Start(ctx context.Context, user *model.User) (*user_service.RefreshResponse, error) {
+	refreshToken, hash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	userAgent, ipAddress := clientInfo(ctx)
+	session := &model.Session{
+		Uuid:             pgtype.UUID{Status: pgtype.Present},
+		UserUuid:         user.Uuid,
+		RefreshTokenHash: hash,
+		UserAgent:        userAgent,
+		IpAddress:        ipAddress,
+	}
+	u, _ := uuid.NewV4()
+	_ = session.Uuid.Set(u.Bytes())
+	_ = session.ExpiresAt.Set(time.Now().Add(s.refreshTokenLifetime))
+
+	if err = s.sessionRepository.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return s.issue(user, u.String(), refreshToken)
+}
+
+// Refresh rotates the refresh token of the session and issues a new access token with the
+// user's current role. Reusing a rotated refresh token revokes its session.
+func (s *SessionService) Refresh(ctx context.Context, request *user_service.RefreshRequest) (*user_service.RefreshResponse, error) {
+	if request.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide refresh token")
+	}
+
+	refreshToken, newHash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hashRefreshToken(request.RefreshToken)
+	session, err := s.sessionRepository.Rotate(ctx, hash, newHash, time.Now().Add(s.refreshTokenLifetime))
+	if err == pgx.ErrNoRows {
+		revoked, err := s.sessionRepository.RevokeByPreviousHash(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			grpclog.Warningf("[SESSIONS] Revoked a session after its rotated refresh token was reused")
+			_ = s.syncRevoked(ctx)
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	} else if err != nil {
+		return nil, err
+	}
+
+	var userUuid, sessionUuid string
+	session.UserUuid.AssignTo(&userUuid)
+	session.Uuid.AssignTo(&sessionUuid)
+
+	user, err := s.userRepository.GetByUuid(ctx, userUuid)
+	if err == pgx.ErrNoRows {
+		if err = s.revoke(ctx, userUuid, ""); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return s.issue(user, sessionUuid, refreshToken)
+}
+
+// Logout revokes the session of the request's token, the given session or all sessions of the user
+func (s *SessionService) Logout(ctx context.Context, request *user_service.LogoutRequest) (*user_service.LogoutResponse, error) {
+	claims, ok := common.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "provide user token")
+	}
+
+	if request.All {
+		if err := s.revoke(ctx, claims.Uuid, ""); err != nil {
+			return nil, err
+		}
+		return &user_service.LogoutResponse{}, nil
+	}
+
+	sessionUuid := request.SessionUuid
+	if sessionUuid == "" {
+		sessionUuid = claims.SessionUuid
+	}
+	if sessionUuid == "" {
+		return nil, status.Error(codes.FailedPrecondition, "token has no session, log out of all sessions instead")
+	}
+
+	revoked, err := s.sessionRepository.Revoke(ctx, claims.Uuid, sessionUuid)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		return nil, status.Error(codes.NotFound, "no active session found")
+	}
+	s.markRevoked(sessionUuid)
+
+	return &user_service.LogoutResponse{}, nil
+}
+
+// Sessions lists the active sessions of the request's user
+func (s *SessionService) Sessions(ctx context.Context, request *user_service.SessionsRequest) (*user_service.SessionsResponse, error) {
+	claims, ok := common.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "provide user token")
+	}
+
+	sessions, err := s.sessionRepository.GetActiveByUser(ctx, claims.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &user_service.SessionsResponse{Sessions: []*user_service.Session{}}
+	for _, session := range *sessions {
+		p := session.ToProto()
+		p.Current = p.Uuid == claims.SessionUuid
+		res.Sessions = append(res.Sessions, p)
+	}
+
+	return res, nil
+}
+
+// RevokeAll revokes the sessions of the user other than exceptUuid, which may be empty
+func (s *SessionService) RevokeAll(ctx context.Context, userUuid string, exceptUuid string) error {
+	return s.revoke(ctx, userUuid, exceptUuid)
+}
+
+func (s *SessionService) revoke(ctx context.Context, userUuid string, exceptUuid string) error {
+	uuids, err := s.sessionRepository.RevokeAll(ctx, userUuid, exceptUuid)
+	if err != nil {
+		return err
+	}
+	for _, u := range uuids {
+		s.markRevoked(u)
+	}
+
+	return nil
+}
+
+// AccessToken issues an access token for the session of the user
+func (s *SessionService) AccessToken(user *model.User, sessionUuid string) (string, time.Time, error) {
+	var u string
+	user.Uuid.AssignTo(&u)
+
+	return s.tokenService.Issue(&common.Claims{
+		Uuid:               u,
+		PrivateRole:        user.PrivateRole,
+		SessionUuid:        sessionUuid,
+		MustChangePassword: user.MustChangePassword,
+
+		TotpEnrollmentRequired: s.TotpEnrollmentRequired(user),
+	})
+}
+
+// TotpEnrollmentRequired reports whether the user is an admin who has to enroll TOTP before using the API
+func (s *SessionService) TotpEnrollmentRequired(user *model.User) bool {
+	return s.requireAdminTotp && user.PrivateRole == model.Admin && !user.TotpEnabled
+}
+
+func (s *SessionService) issue(user *model.User, sessionUuid string, refreshToken string) (*user_service.RefreshResponse, error) {
+	token, expiresAt, err := s.AccessToken(user, sessionUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_service.RefreshResponse{
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresAt:    timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (s *SessionService) markRevoked(sessionUuid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.revoked[sessionUuid] = time.Now()
+}
+
+// syncRevoked loads the sessions revoked while their access tokens may still be valid,
+// and forgets the ones whose tokens have expired
+func (s *SessionService) syncRevoked(ctx context.Context) error {
+	// revocations of the last sync are read again, as transactions may commit out of order
+	since := time.Now().Add(-s.tokenService.AccessTokenLifetime())
+	uuids, err := s.sessionRepository.GetRevokedSince(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for u, revokedAt := range s.revoked {
+		if revokedAt.Before(since) {
+			delete(s.revoked, u)
+		}
+	}
+	for _, u := range uuids {
+		if _, ok := s.revoked[u]; !ok {
+			s.revoked[u] = time.Now()
+		}
+	}
+
+	return nil
+}
+
+// newRefreshToken returns a random refresh token and its hash, which is stored in its place
+func newRefreshToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	return token, hashRefreshToken(token), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// clientInfo returns the user agent and address of the client. The address is the peer of the
+// call, or for calls of the HTTP gateway, which connects over loopback, the address it appended to
+// x-forwarded-for. Entries before it are sent by the client and aren't trusted.
+func clientInfo(ctx context.Context) (string, string) {
+	var userAgent, ipAddress string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("grpcgateway-user-agent"); len(v) > 0 {
+		userAgent = v[0]
+	} else if v := md.Get("user-agent"); len(v) > 0 {
+		userAgent = v[0]
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		ipAddress = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ipAddress); err == nil {
+			ipAddress = host
+		}
+	}
+	if ip := net.ParseIP(ipAddress); ip != nil && ip.IsLoopback() {
+		if v := md.Get("x-forwarded-for"); len(v) > 0 {
+			entries := strings.Split(v[len(v)-1], ",")
+			ipAddress = strings.TrimSpace(entries[len(entries)-1])
+		}
+	}
+
+	return userAgent, ipAddress
+}
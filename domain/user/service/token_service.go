@@ -0,0 +1,483 @@
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/jackc/pgtype"
+	"google.golang.org/grpc/grpclog"
+)
+
+// mfaTokenLifetime is how long users have to enter their second factor after their password
+const mfaTokenLifetime = 5 * time.Minute
+
+const (
+	defaultAccessTokenLifetime = 15 * time.Minute
+	defaultKeyRotation         = 30 * 24 * time.Hour
+	defaultAudience            = "analysis-web"
+	defaultIssuer              = "analysis-api"
+)
+
+// keys are reloaded at most this often when a token has an unknown kid, e.g. after another replica rotated them
+const unknownKidReloadInterval = 10 * time.Second
+
+// signingKey is a parsed signing key
+type signingKey struct {
+	kid       string
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+	retired   bool
+	createdAt time.Time
+}
+
+// TokenService issues and validates user tokens. Tokens are signed with the newest signing key and
+// carry its kid, so any key which hasn't expired can verify them while keys are rotated.
+type TokenService struct {
+	signingKeyRepository repo.SigningKeyRepositoryContract
+	config               *common.Config
+	algorithm            string
+	rotation             time.Duration
+	accessTokenLifetime  time.Duration
+	audience             string
+	issuer               string
+
+	mu         sync.RWMutex
+	keys       map[string]*signingKey
+	current    *signingKey
+	lastReload time.Time
+	// rolloutAt is when the first signing key was created, legacy tokens must have been issued before it
+	rolloutAt time.Time
+}
+
+func NewTokenService(signingKeyRepository repo.SigningKeyRepositoryContract, config *common.Config) (*TokenService, error) {
+	s := &TokenService{
+		signingKeyRepository: signingKeyRepository,
+		config:               config,
+		algorithm:            config.JwtAlgorithm,
+		rotation:             time.Duration(config.JwtKeyRotationHours) * time.Hour,
+		accessTokenLifetime:  time.Duration(config.AccessTokenMinutes) * time.Minute,
+		audience:             config.JwtAudience,
+		issuer:               config.JwtIssuer,
+		keys:                 make(map[string]*signingKey),
+	}
+	if s.algorithm == "" {
+		s.algorithm = model.HS256
+	}
+	if s.algorithm != model.HS256 && s.algorithm != model.RS256 && s.algorithm != model.EdDSA {
+		return nil, fmt.Errorf("unsupported jwt algorithm %s", s.algorithm)
+	}
+	if s.rotation <= 0 {
+		s.rotation = defaultKeyRotation
+	}
+	if s.accessTokenLifetime <= 0 {
+		s.accessTokenLifetime = defaultAccessTokenLifetime
+	}
+	if s.audience == "" {
+		s.audience = defaultAudience
+	}
+	if s.issuer == "" {
+		s.issuer = defaultIssuer
+	}
+
+	return s, nil
+}
+
+// Init loads the signing keys, creates the first one if there are none and loads when it was created
+func (s *TokenService) Init(ctx context.Context) error {
+	if err := s.reload(ctx); err != nil {
+		return err
+	}
+	if s.currentKey() == nil {
+		key, err := generateSigningKey(s.algorithm)
+		if err != nil {
+			return err
+		}
+		if err = s.signingKeyRepository.Create(ctx, key); err != nil {
+			return err
+		}
+		if err = s.reload(ctx); err != nil {
+			return err
+		}
+	}
+
+	rolloutAt, err := s.signingKeyRepository.RolloutAt(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rolloutAt = rolloutAt
+
+	return nil
+}
+
+// Run reloads the keys every minute and rotates the current one once it is older than
+// the rotation interval or of another algorithm than configured, until the context is done
+func (s *TokenService) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.reload(ctx); err != nil {
+				grpclog.Errorf("[TOKEN SERVICE] Failed to reload signing keys: %v", err)
+				continue
+			}
+			if err := s.rotateIfDue(ctx); err != nil {
+				grpclog.Errorf("[TOKEN SERVICE] Failed to rotate signing keys: %v", err)
+			}
+		}
+	}
+}
+
+// Issue signs a short-lived access token with the claims of the user with the current key
+// and returns it with its expiry. The registered claims are set by the service.
+func (s *TokenService) Issue(claims *common.Claims) (string, time.Time, error) {
+	return s.issue(claims, s.audience, s.accessTokenLifetime)
+}
+
+// IssueMfa signs a token proving the user passed the first stage of a login, which is exchanged for
+// access tokens with a second factor. Its audience differs, so it isn't accepted as an access token.
+func (s *TokenService) IssueMfa(uuid string) (string, error) {
+	token, _, err := s.issue(&common.Claims{Uuid: uuid}, s.mfaAudience(), mfaTokenLifetime)
+	return token, err
+}
+
+func (s *TokenService) issue(claims *common.Claims, audience string, lifetime time.Duration) (string, time.Time, error) {
+	key := s.currentKey()
+	if key == nil {
+		return "", time.Time{}, errors.New("no signing key")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(lifetime)
+	claims.StandardClaims = jwt.StandardClaims{
+		Audience:  audience,
+		ExpiresAt: expiresAt.Unix(),
+		IssuedAt:  now.Unix(),
+		Issuer:    s.issuer,
+	}
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+	tokenString, err := token.SignedString(key.signKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expiresAt, nil
+}
+
+// AccessTokenLifetime is how long issued access tokens are valid
+func (s *TokenService) AccessTokenLifetime() time.Duration {
+	return s.accessTokenLifetime
+}
+
+// Validate verifies the token's signature with the key of its kid and requires its audience,
+// issuer and expiry to be set and valid. Tokens without a kid were issued before keys were
+// rotated, and are verified with the configured secret if they were issued before the first
+// key was created, for one access token lifetime after it.
+func (s *TokenService) Validate(ctx context.Context, tokenString string) (*common.Claims, error) {
+	return s.validate(ctx, tokenString, s.audience, true)
+}
+
+// ValidateMfa validates a token issued by IssueMfa
+func (s *TokenService) ValidateMfa(ctx context.Context, tokenString string) (*common.Claims, error) {
+	return s.validate(ctx, tokenString, s.mfaAudience(), false)
+}
+
+func (s *TokenService) mfaAudience() string {
+	return s.audience + "/mfa"
+}
+
+func (s *TokenService) validate(ctx context.Context, tokenString string, audience string, allowLegacy bool) (*common.Claims, error) {
+	claims := &common.Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, hasKid := token.Header["kid"].(string)
+		if !hasKid {
+			if !allowLegacy {
+				return nil, errors.New("token has no kid")
+			}
+			if token.Method != jwt.SigningMethodHS256 || s.config.JwtSigningSecret == "" {
+				return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+			}
+			if !s.legacyAllowed(claims) {
+				return nil, errors.New("token has no kid")
+			}
+			return []byte(s.config.JwtSigningSecret), nil
+		}
+
+		key := s.key(ctx, kid)
+		if key == nil {
+			return nil, fmt.Errorf("unknown key %s", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return key.verifyKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.ExpiresAt == 0 {
+		return nil, errors.New("token has no expiry")
+	}
+	if !claims.VerifyAudience(audience, true) {
+		return nil, errors.New("invalid token audience")
+	}
+	if !claims.VerifyIssuer(s.issuer, true) {
+		return nil, errors.New("invalid token issuer")
+	}
+
+	return claims, nil
+}
+
+// Jwks returns the public keys tokens can be verified with. HMAC keys are secret and left out.
+func (s *TokenService) Jwks() *user_service.JwksResponse {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	res := &user_service.JwksResponse{Keys: []*user_service.Jwk{}}
+	for _, key := range s.keys {
+		switch k := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			res.Keys = append(res.Keys, &user_service.Jwk{
+				Kty: "RSA",
+				Kid: key.kid,
+				Use: "sig",
+				Alg: model.RS256,
+				N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			res.Keys = append(res.Keys, &user_service.Jwk{
+				Kty: "OKP",
+				Kid: key.kid,
+				Use: "sig",
+				Alg: model.EdDSA,
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(k),
+			})
+		}
+	}
+
+	return res
+}
+
+// legacyAllowed reports whether a token without a kid may still be verified with the configured
+// secret. It must have been issued before the first signing key was created, and is only accepted
+// for one access token lifetime after, so the secret can't mint new tokens.
+func (s *TokenService) legacyAllowed(claims *common.Claims) bool {
+	s.mu.RLock()
+	rolloutAt := s.rolloutAt
+	s.mu.RUnlock()
+
+	if rolloutAt.IsZero() || claims.IssuedAt == 0 {
+		return false
+	}
+	if time.Now().After(rolloutAt.Add(s.accessTokenLifetime)) {
+		return false
+	}
+	return !time.Unix(claims.IssuedAt, 0).After(rolloutAt)
+}
+
+func (s *TokenService) currentKey() *signingKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.current
+}
+
+// key returns the key of the kid, reloading the keys if it isn't known yet
+func (s *TokenService) key(ctx context.Context, kid string) *signingKey {
+	s.mu.RLock()
+	key, ok := s.keys[kid]
+	recentlyReloaded := time.Since(s.lastReload) < unknownKidReloadInterval
+	s.mu.RUnlock()
+	if ok || recentlyReloaded {
+		return key
+	}
+
+	if err := s.reload(ctx); err != nil {
+		grpclog.Errorf("[TOKEN SERVICE] Failed to reload signing keys: %v", err)
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.keys[kid]
+}
+
+// reload replaces the cached keys with the ones which haven't expired
+func (s *TokenService) reload(ctx context.Context) error {
+	stored, err := s.signingKeyRepository.GetValid(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*signingKey)
+	var current *signingKey
+	for i := range *stored {
+		key, err := parseSigningKey(&(*stored)[i])
+		if err != nil {
+			grpclog.Errorf("[TOKEN SERVICE] Skipping signing key %s: %v", (*stored)[i].Kid, err)
+			continue
+		}
+		keys[key.kid] = key
+		// keys are ordered newest first
+		if current == nil && !key.retired {
+			current = key
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	s.current = current
+	s.lastReload = time.Now()
+
+	return nil
+}
+
+// rotateIfDue retires the current key and creates a new one. Retired keys verify tokens until
+// the last token they signed expires. Only one replica rotates a key, the rest pick up the new
+// one when reloading.
+func (s *TokenService) rotateIfDue(ctx context.Context) error {
+	current := s.currentKey()
+	if current == nil {
+		return s.Init(ctx)
+	}
+	if time.Since(current.createdAt) < s.rotation && current.method.Alg() == s.algorithm {
+		return nil
+	}
+
+	key, err := generateSigningKey(s.algorithm)
+	if err != nil {
+		return err
+	}
+	rotated, err := s.signingKeyRepository.Rotate(ctx, current.kid, time.Now().Add(s.accessTokenLifetime), key)
+	if err != nil {
+		return err
+	}
+	if rotated {
+		grpclog.Infof("[TOKEN SERVICE] Rotated signing key %s to %s", current.kid, key.Kid)
+	}
+
+	if err = s.signingKeyRepository.DeleteExpired(ctx); err != nil {
+		return err
+	}
+
+	return s.reload(ctx)
+}
+
+// generateSigningKey creates a key of the algorithm with a random kid
+func generateSigningKey(algorithm string) (*model.SigningKey, error) {
+	kid := make([]byte, 12)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, err
+	}
+	key := &model.SigningKey{
+		Kid:       base64.RawURLEncoding.EncodeToString(kid),
+		Algorithm: algorithm,
+	}
+
+	switch algorithm {
+	case model.HS256:
+		key.PrivateKey = make([]byte, 32)
+		if _, err := rand.Read(key.PrivateKey); err != nil {
+			return nil, err
+		}
+		return key, nil
+	case model.RS256:
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		return marshalKeyPair(key, privateKey, &privateKey.PublicKey)
+	case model.EdDSA:
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return marshalKeyPair(key, privateKey, publicKey)
+	}
+
+	return nil, fmt.Errorf("unsupported jwt algorithm %s", algorithm)
+}
+
+func marshalKeyPair(key *model.SigningKey, privateKey interface{}, publicKey interface{}) (*model.SigningKey, error) {
+	var err error
+	key.PrivateKey, err = x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	key.PublicKey, err = x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func parseSigningKey(stored *model.SigningKey) (*signingKey, error) {
+	key := &signingKey{
+		kid:       stored.Kid,
+		retired:   stored.RetiredAt.Status == pgtype.Present,
+		createdAt: stored.CreatedAt.Time,
+	}
+
+	if stored.Algorithm == model.HS256 {
+		key.method = jwt.SigningMethodHS256
+		key.signKey = stored.PrivateKey
+		key.verifyKey = stored.PrivateKey
+		return key, nil
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(stored.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(stored.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	key.signKey = privateKey
+	key.verifyKey = publicKey
+
+	switch stored.Algorithm {
+	case model.RS256:
+		if _, ok := publicKey.(*rsa.PublicKey); !ok {
+			return nil, errors.New("not an RSA key")
+		}
+		key.method = jwt.SigningMethodRS256
+	case model.EdDSA:
+		if _, ok := publicKey.(ed25519.PublicKey); !ok {
+			return nil, errors.New("not an Ed25519 key")
+		}
+		key.method = SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %s", stored.Algorithm)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
+	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/jackc/pgtype"
+)
+
+const (
+	testJwtSecret = "legacy-secret"
+	testUserUuid  = "6f1c2a9e-5d4b-4e3a-9c2f-1b0a8d7e6c5b"
+)
+
+// fakeSigningKeyRepository keeps the signing keys newest first like the signing_keys table
+type fakeSigningKeyRepository struct {
+	repo.SigningKeyRepositoryContract
+	keys      []model.SigningKey
+	rolloutAt time.Time
+}
+
+func (r *fakeSigningKeyRepository) GetValid(_ context.Context) (*[]model.SigningKey, error) {
+	var valid []model.SigningKey
+	for _, key := range r.keys {
+		if key.ExpiresAt.Status != pgtype.Present || key.ExpiresAt.Time.After(time.Now()) {
+			valid = append(valid, key)
+		}
+	}
+	return &valid, nil
+}
+
+func (r *fakeSigningKeyRepository) Create(_ context.Context, key *model.SigningKey) error {
+	stored := *key
+	stored.CreatedAt = pgtype.Timestamptz{Time: time.Now(), Status: pgtype.Present}
+	stored.RetiredAt = pgtype.Timestamptz{Status: pgtype.Null}
+	stored.ExpiresAt = pgtype.Timestamptz{Status: pgtype.Null}
+	r.keys = append([]model.SigningKey{stored}, r.keys...)
+	return nil
+}
+
+func (r *fakeSigningKeyRepository) Rotate(ctx context.Context, currentKid string, expiresAt time.Time, key *model.SigningKey) (bool, error) {
+	for i := range r.keys {
+		if r.keys[i].Kid != currentKid || r.keys[i].RetiredAt.Status == pgtype.Present {
+			continue
+		}
+		r.keys[i].RetiredAt = pgtype.Timestamptz{Time: time.Now(), Status: pgtype.Present}
+		r.keys[i].ExpiresAt = pgtype.Timestamptz{Time: expiresAt, Status: pgtype.Present}
+		return true, r.Create(ctx, key)
+	}
+	return false, nil
+}
+
+func (r *fakeSigningKeyRepository) DeleteExpired(_ context.Context) error {
+	return nil
+}
+
+func (r *fakeSigningKeyRepository) RolloutAt(_ context.Context) (time.Time, error) {
+	if r.rolloutAt.IsZero() {
+		r.rolloutAt = r.keys[len(r.keys)-1].CreatedAt.Time
+	}
+	return r.rolloutAt, nil
+}
+
+func newTestTokenService(t *testing.T, rolloutAt time.Time) (*TokenService, *fakeSigningKeyRepository) {
+	keys := &fakeSigningKeyRepository{rolloutAt: rolloutAt}
+	s, err := NewTokenService(keys, &common.Config{JwtSigningSecret: testJwtSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Init(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return s, keys
+}
+
+// signTestToken signs the claims with the method and key, and sets the kid if there is one
+func signTestToken(t *testing.T, method jwt.SigningMethod, key interface{}, kid string, claims *common.Claims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	tokenString, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokenString
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Now()
+	s, _ := newTestTokenService(t, now.Add(-time.Minute))
+	current := s.currentKey()
+
+	issued, _, err := s.Issue(&common.Claims{Uuid: testUserUuid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mfa, err := s.IssueMfa(testUserUuid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := func(audience, issuer string, issuedAt, expiresAt time.Time) *common.Claims {
+		c := &common.Claims{Uuid: testUserUuid}
+		c.Audience = audience
+		c.Issuer = issuer
+		if !issuedAt.IsZero() {
+			c.IssuedAt = issuedAt.Unix()
+		}
+		if !expiresAt.IsZero() {
+			c.ExpiresAt = expiresAt.Unix()
+		}
+		return c
+	}
+	valid := claims(defaultAudience, defaultIssuer, now, now.Add(time.Minute))
+	legacy := claims(defaultAudience, defaultIssuer, now.Add(-2*time.Minute), now.Add(time.Minute))
+
+	tests := []struct {
+		name  string
+		token string
+		ok    bool
+	}{
+		{"issued token", issued, true},
+		{"mfa token", mfa, false},
+		{"current kid", signTestToken(t, current.method, current.signKey, current.kid, valid), true},
+		{"unknown kid", signTestToken(t, current.method, current.signKey, "unknown", valid), false},
+		{"other algorithm", signTestToken(t, jwt.SigningMethodHS384, current.signKey, current.kid, valid), false},
+		{"other secret", signTestToken(t, current.method, []byte("other"), current.kid, valid), false},
+		{"wrong audience", signTestToken(t, current.method, current.signKey, current.kid,
+			claims("other", defaultIssuer, now, now.Add(time.Minute))), false},
+		{"no audience", signTestToken(t, current.method, current.signKey, current.kid,
+			claims("", defaultIssuer, now, now.Add(time.Minute))), false},
+		{"wrong issuer", signTestToken(t, current.method, current.signKey, current.kid,
+			claims(defaultAudience, "other", now, now.Add(time.Minute))), false},
+		{"no issuer", signTestToken(t, current.method, current.signKey, current.kid,
+			claims(defaultAudience, "", now, now.Add(time.Minute))), false},
+		{"no expiry", signTestToken(t, current.method, current.signKey, current.kid,
+			claims(defaultAudience, defaultIssuer, now, time.Time{})), false},
+		{"expired", signTestToken(t, current.method, current.signKey, current.kid,
+			claims(defaultAudience, defaultIssuer, now.Add(-time.Hour), now.Add(-time.Minute))), false},
+		{"legacy before rollout", signTestToken(t, jwt.SigningMethodHS256, []byte(testJwtSecret), "", legacy), true},
+		{"legacy after rollout", signTestToken(t, jwt.SigningMethodHS256, []byte(testJwtSecret), "", valid), false},
+		{"legacy without issued at", signTestToken(t, jwt.SigningMethodHS256, []byte(testJwtSecret), "",
+			claims(defaultAudience, defaultIssuer, time.Time{}, now.Add(time.Minute))), false},
+		{"legacy other algorithm", signTestToken(t, jwt.SigningMethodHS384, []byte(testJwtSecret), "", legacy), false},
+		{"legacy other secret", signTestToken(t, jwt.SigningMethodHS256, []byte("other"), "", legacy), false},
+	}
+
+	for _, test := range tests {
+		_, err := s.Validate(context.Background(), test.token)
+		if (err == nil) != test.ok {
+			t.Errorf("%s: got error %v, want ok %v", test.name, err, test.ok)
+		}
+	}
+
+	if _, err = s.ValidateMfa(context.Background(), mfa); err != nil {
+		t.Errorf("mfa token: %v", err)
+	}
+	if _, err = s.ValidateMfa(context.Background(), issued); err == nil {
+		t.Error("access token was accepted as mfa token")
+	}
+	if _, err = s.ValidateMfa(context.Background(), signTestToken(t, jwt.SigningMethodHS256, []byte(testJwtSecret), "",
+		claims(s.mfaAudience(), defaultIssuer, now.Add(-2*time.Minute), now.Add(time.Minute)))); err == nil {
+		t.Error("legacy token was accepted as mfa token")
+	}
+}
+
+func TestValidateLegacyWindow(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		rolloutAt time.Time
+		ok        bool
+	}{
+		{"within an access token lifetime", now.Add(-time.Minute), true},
+		{"after an access token lifetime", now.Add(-defaultAccessTokenLifetime - time.Minute), false},
+	}
+
+	for _, test := range tests {
+		s, _ := newTestTokenService(t, test.rolloutAt)
+		claims := &common.Claims{Uuid: testUserUuid}
+		claims.Audience = defaultAudience
+		claims.Issuer = defaultIssuer
+		claims.IssuedAt = test.rolloutAt.Add(-time.Minute).Unix()
+		claims.ExpiresAt = now.Add(time.Minute).Unix()
+
+		_, err := s.Validate(context.Background(), signTestToken(t, jwt.SigningMethodHS256, []byte(testJwtSecret), "", claims))
+		if (err == nil) != test.ok {
+			t.Errorf("%s: got error %v, want ok %v", test.name, err, test.ok)
+		}
+	}
+}
+
+func TestValidateLegacyWindowKeepsFirstRollout(t *testing.T) {
+	now := time.Now()
+	s, keys := newTestTokenService(t, now.Add(-defaultAccessTokenLifetime-time.Minute))
+
+	// a new key doesn't move the rollout, even once the first one is gone
+	keys.keys = nil
+	if err := s.Init(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	claims := &common.Claims{Uuid: testUserUuid}
+	claims.Audience = defaultAudience
+	claims.Issuer = defaultIssuer
+	claims.IssuedAt = now.Add(-time.Minute).Unix()
+	claims.ExpiresAt = now.Add(time.Minute).Unix()
+	if _, err := s.Validate(context.Background(), signTestToken(t, jwt.SigningMethodHS256, []byte(testJwtSecret), "", claims)); err == nil {
+		t.Error("legacy token was accepted after the first rollout")
+	}
+}
+
+func TestRotateIfDue(t *testing.T) {
+	s, keys := newTestTokenService(t, time.Time{})
+	ctx := context.Background()
+	first := s.currentKey()
+	firstToken, _, err := s.Issue(&common.Claims{Uuid: testUserUuid})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.rotateIfDue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if s.currentKey().kid != first.kid {
+		t.Fatal("key was rotated before it was due")
+	}
+
+	// rotating to another algorithm
+	s.algorithm = model.EdDSA
+	if err = s.rotateIfDue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	second := s.currentKey()
+	if second.kid == first.kid || second.method.Alg() != model.EdDSA {
+		t.Fatalf("current key %s %s, want a new %s key", second.kid, second.method.Alg(), model.EdDSA)
+	}
+	secondToken, _, err := s.Issue(&common.Claims{Uuid: testUserUuid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jwks := s.Jwks(); len(jwks.Keys) != 1 || jwks.Keys[0].Kid != second.kid {
+		t.Errorf("jwks %v, want only the public key of %s", jwks.Keys, second.kid)
+	}
+
+	// rotating an aged key
+	keys.keys[0].CreatedAt.Time = time.Now().Add(-defaultKeyRotation - time.Minute)
+	if err = s.reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.rotateIfDue(ctx); err != nil {
+		t.Fatal(err)
+	}
+	third := s.currentKey()
+	if third.kid == second.kid {
+		t.Fatal("aged key wasn't rotated")
+	}
+
+	// retired keys verify tokens until they expire
+	for _, token := range []string{firstToken, secondToken} {
+		if _, err = s.Validate(ctx, token); err != nil {
+			t.Errorf("token of a retired key: %v", err)
+		}
+	}
+	keys.keys[2].ExpiresAt.Time = time.Now().Add(-time.Second)
+	if err = s.reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Validate(ctx, firstToken); err == nil {
+		t.Error("token of an expired key was accepted")
+	}
+}
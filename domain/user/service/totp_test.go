@@ -0,0 +1,62 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA-1 secret of the test vectors of RFC 6238
+const rfc6238Secret = "12345678901234567890"
+
+func TestTotpCode(t *testing.T) {
+	// the vectors have 8 digits, of which the codes are the last 6
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, test := range tests {
+		if code := totpCode([]byte(rfc6238Secret), test.unix/totpPeriod); code != test.code {
+			t.Errorf("code at %d = %s, want %s", test.unix, code, test.code)
+		}
+	}
+}
+
+func TestVerifyTotp(t *testing.T) {
+	secret := totpEncoding.EncodeToString([]byte(rfc6238Secret))
+	now := time.Unix(1111111111, 0)
+	current := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		step   int64
+		ok     bool
+	}{
+		{"current step", secret, totpCode([]byte(rfc6238Secret), current), current, true},
+		{"previous step", secret, totpCode([]byte(rfc6238Secret), current-1), current - 1, true},
+		{"next step", secret, totpCode([]byte(rfc6238Secret), current+1), current + 1, true},
+		{"beyond skew before", secret, totpCode([]byte(rfc6238Secret), current-2), 0, false},
+		{"beyond skew after", secret, totpCode([]byte(rfc6238Secret), current+2), 0, false},
+		{"lower case secret", strings.ToLower(secret), "050471", current, true},
+		{"wrong code", secret, "000000", 0, false},
+		{"wrong length", secret, "50471", 0, false},
+		{"invalid secret", "not base32!", "050471", 0, false},
+	}
+
+	for _, test := range tests {
+		step, ok := verifyTotp(test.secret, test.code, now)
+		if ok != test.ok || step != test.step {
+			t.Errorf("%s: got step %d, %v, want %d, %v", test.name, step, ok, test.step, test.ok)
+		}
+	}
+}
@@ -2,18 +2,19 @@ package service
 
 import (
 	"context"
-	"time"
 
 	"github.com/vectorman1/analysis/analysis-api/domain/user/repo"
 
 	"github.com/vectorman1/analysis/analysis-api/domain/user/model"
 
 	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx"
 
 	validation "github.com/vectorman1/analysis/analysis-api/common/errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -21,69 +22,131 @@ import (
 
 	"github.com/vectorman1/analysis/analysis-api/common"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
 )
 
+// Postgres error code of unique constraint violations
+const uniqueViolation = "23505"
+
 type UserServiceContract interface {
 	Login(context.Context, *user_service.LoginRequest) (*user_service.LoginResponse, error)
+	LoginMfa(context.Context, *user_service.LoginMfaRequest) (*user_service.LoginResponse, error)
+	OidcAuthorize(context.Context, *user_service.OidcAuthorizeRequest) (*user_service.OidcAuthorizeResponse, error)
+	OidcCallback(context.Context, *user_service.OidcCallbackRequest) (*user_service.LoginResponse, error)
+	GetMe(context.Context, *user_service.GetMeRequest) (*user_service.Profile, error)
+	UpdateMe(context.Context, *user_service.UpdateMeRequest) (*user_service.Profile, error)
 	Register(context.Context, *user_service.RegisterRequest) (*user_service.RegisterResponse, error)
 	GetPaged(context.Context, *user_service.GetPagedRequest) (*user_service.GetPagedResponse, error)
 	Create(context.Context, *user_service.CreateRequest) (*user_service.CreateResponse, error)
+	ChangePassword(context.Context, *user_service.ChangePasswordRequest) (*user_service.ChangePasswordResponse, error)
 	Update(context.Context, *user_service.UpdateRequest) (*user_service.UpdateResponse, error)
 	Delete(context.Context, *user_service.DeleteRequest) (*user_service.DeleteResponse, error)
+	EnrollTotp(context.Context, *user_service.EnrollTotpRequest) (*user_service.EnrollTotpResponse, error)
+	ConfirmTotp(context.Context, *user_service.ConfirmTotpRequest) (*user_service.RecoveryCodesResponse, error)
+	DisableTotp(context.Context, *user_service.DisableTotpRequest) (*user_service.DisableTotpResponse, error)
+	RegenerateRecoveryCodes(context.Context, *user_service.RegenerateRecoveryCodesRequest) (*user_service.RecoveryCodesResponse, error)
+	Refresh(context.Context, *user_service.RefreshRequest) (*user_service.RefreshResponse, error)
+	Logout(context.Context, *user_service.LogoutRequest) (*user_service.LogoutResponse, error)
+	Sessions(context.Context, *user_service.SessionsRequest) (*user_service.SessionsResponse, error)
+	CreateApiKey(context.Context, *user_service.CreateApiKeyRequest) (*user_service.CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *user_service.ListApiKeysRequest) (*user_service.ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *user_service.RevokeApiKeyRequest) (*user_service.RevokeApiKeyResponse, error)
+	Unlock(context.Context, *user_service.UnlockRequest) (*user_service.UnlockResponse, error)
+	LoginAttempts(context.Context, *user_service.LoginAttemptsRequest) (*user_service.LoginAttemptsResponse, error)
+	Jwks(context.Context, *user_service.JwksRequest) (*user_service.JwksResponse, error)
 }
 
 type UserService struct {
 	userRepository *repo.UserRepository
+	tokenService   *TokenService
+	sessionService *SessionService
+	apiKeyService  *ApiKeyService
+	loginGuard     *LoginGuard
+	passwordPolicy *PasswordPolicy
+	mfaService     *MfaService
+	oidcService    *OidcService
+	profileService *ProfileService
 	config         *common.Config
 }
 
-func NewUserService(userRepository *repo.UserRepository, config *common.Config) *UserService {
+func NewUserService(
+	userRepository *repo.UserRepository,
+	tokenService *TokenService,
+	sessionService *SessionService,
+	apiKeyService *ApiKeyService,
+	loginGuard *LoginGuard,
+	passwordPolicy *PasswordPolicy,
+	mfaService *MfaService,
+	oidcService *OidcService,
+	profileService *ProfileService,
+	config *common.Config) *UserService {
 	return &UserService{
 		userRepository: userRepository,
+		tokenService:   tokenService,
+		sessionService: sessionService,
+		apiKeyService:  apiKeyService,
+		loginGuard:     loginGuard,
+		passwordPolicy: passwordPolicy,
+		mfaService:     mfaService,
+		oidcService:    oidcService,
+		profileService: profileService,
 		config:         config,
 	}
 }
 
 // Login attempts to find a user with a matching username, verifies the password
-// and returns a response with a Token or an error
+// and starts a session, returning its access and refresh tokens or an error.
+// Users with two-factor authentication get an MFA token to complete the login with.
 func (s *UserService) Login(ctx context.Context, request *user_service.LoginRequest) (*user_service.LoginResponse, error) {
 	if request.Username == "" || request.Password == "" {
 		return nil, status.Error(codes.InvalidArgument, validation.WrongUsernameOrPassword)
 	}
 
+	attempt, err := s.loginGuard.Check(ctx, request.Username)
+	if err != nil {
+		return nil, err
+	}
+	defer s.loginGuard.Release(ctx, attempt)
+
 	user, err := s.userRepository.GetByUsername(ctx, request.Username)
 	if err != nil {
+		if err != pgx.ErrNoRows {
+			return nil, err
+		}
+		s.loginGuard.RecordFailure(ctx, attempt)
 		return nil, status.Errorf(codes.InvalidArgument, validation.WrongUsernameOrPassword)
 	}
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
 	if err != nil {
+		s.loginGuard.RecordFailure(ctx, attempt)
 		return nil, status.Errorf(codes.InvalidArgument, validation.WrongUsernameOrPassword)
 	}
 
-	var u string
-	user.Uuid.AssignTo(&u)
-
-	expTime := time.Now().Add(24 * 60 * time.Minute)
-	claims := &common.Claims{
-		Uuid:        u,
-		PrivateRole: user.PrivateRole,
-		StandardClaims: jwt.StandardClaims{
-			Audience:  "analysis-web",
-			ExpiresAt: expTime.Unix(),
-			IssuedAt:  time.Now().Unix(),
-			Issuer:    "analysis-api",
-		},
+	// the login is recorded as successful once the second factor is verified, as recording it now
+	// would reset the failures which limit guessing codes
+	if user.TotpEnabled {
+		mfaToken, err := s.tokenService.IssueMfa(uuidOf(user))
+		if err != nil {
+			return nil, err
+		}
+		s.loginGuard.RecordPassed(ctx, attempt)
+		return &user_service.LoginResponse{MfaRequired: true, MfaToken: mfaToken}, nil
 	}
+	s.loginGuard.RecordSuccess(ctx, attempt)
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.config.JwtSigningSecret))
+	tokens, err := s.sessionService.Start(ctx, user)
 	if err != nil {
 		return nil, err
 	}
 
-	return &user_service.LoginResponse{Token: tokenString}, nil
+	return &user_service.LoginResponse{
+		Token:              tokens.Token,
+		RefreshToken:       tokens.RefreshToken,
+		ExpiresAt:          tokens.ExpiresAt,
+		MustChangePassword: user.MustChangePassword,
+
+		TotpEnrollmentRequired: s.sessionService.TotpEnrollmentRequired(user),
+	}, nil
 }
 
 // Register attempts to create a User with the corresponding
@@ -92,12 +155,12 @@ func (s *UserService) Register(ctx context.Context, request *user_service.Regist
 	if request.Username == "" || request.Password == "" {
 		return nil, status.Error(codes.InvalidArgument, validation.InvalidUsernameOrPassword)
 	}
-	if len(request.Password) < 8 {
-		return nil, status.Error(codes.InvalidArgument, "Minimum password length is 8.")
-	}
 	if len(request.Username) < 6 {
 		return nil, status.Error(codes.InvalidArgument, "Minimum username length is 6.")
 	}
+	if err := s.passwordPolicy.Validate(request.Password, request.Username); err != nil {
+		return nil, err
+	}
 
 	user := &model.User{
 		Uuid:        pgtype.UUID{Status: pgtype.Present},
@@ -128,11 +191,32 @@ func (s *UserService) Register(ctx context.Context, request *user_service.Regist
 		return nil, err
 	}
 
-	return &user_service.RegisterResponse{Token: loginResponse.Token}, nil
+	return &user_service.RegisterResponse{
+		Token:        loginResponse.Token,
+		RefreshToken: loginResponse.RefreshToken,
+		ExpiresAt:    loginResponse.ExpiresAt,
+	}, nil
+}
+
+// userQueryFields are the fields users can be sorted and filtered by
+var userQueryFields = common.QueryFields{
+	"username":    {Column: "username", Type: common.TextField},
+	"privateRole": {Column: "privateRole", Type: common.IntegerField},
+	"createdAt":   {Column: "createdAt", Type: common.TimeField},
+	"updatedAt":   {Column: "updatedAt", Type: common.TimeField},
 }
 
 func (s *UserService) GetPaged(ctx context.Context, request *user_service.GetPagedRequest) (*user_service.GetPagedResponse, error) {
-	users, total, err := s.userRepository.GetPaged(ctx, request.Filter)
+	if request.Filter == nil {
+		return nil, status.Error(codes.InvalidArgument, "provide filter")
+	}
+
+	pagedQuery, err := userQueryFields.Compile(common.PagedSpecFromProto(request.Filter))
+	if err != nil {
+		return nil, err
+	}
+
+	users, total, nextPageToken, err := s.userRepository.GetPaged(ctx, pagedQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -143,38 +227,331 @@ func (s *UserService) GetPaged(ctx context.Context, request *user_service.GetPag
 	}
 
 	return &user_service.GetPagedResponse{
-		Items:      protoUsers,
-		TotalItems: uint64(total),
+		Items:         protoUsers,
+		TotalItems:    uint64(total),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// Create creates a user with a generated password, which the user has to change after logging in
 func (s *UserService) Create(ctx context.Context, request *user_service.CreateRequest) (*user_service.CreateResponse, error) {
-	password := common.RandomStringWithLength(10)
+	if len(request.Username) < 6 {
+		return nil, status.Error(codes.InvalidArgument, "Minimum username length is 6.")
+	}
+	role := model.PrivateRole(request.PrivateRole)
+	if role != model.Default && role != model.Admin {
+		return nil, status.Error(codes.InvalidArgument, "unknown role")
+	}
+
+	password, err := s.passwordPolicy.Generate()
+	if err != nil {
+		return nil, err
+	}
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), 10)
 	if err != nil {
 		return nil, err
 	}
 
 	user := &model.User{
-		PrivateRole: model.PrivateRole(request.PrivateRole),
-		Username:    request.Username,
-		Password:    string(hashedPassword),
+		PrivateRole:        role,
+		Username:           request.Username,
+		Password:           string(hashedPassword),
+		MustChangePassword: true,
 	}
 	u, _ := uuid.NewV4()
 	user.Uuid.Set(u.Bytes())
 
 	err = s.userRepository.Create(ctx, user)
 	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok && pgErr.Code == uniqueViolation {
+			return nil, status.Error(codes.AlreadyExists, validation.UsernameTaken)
+		}
 		return nil, err
 	}
 
 	return &user_service.CreateResponse{Password: password}, nil
 }
 
+// Update changes the username, password or role of a user. Users can update their username,
+// while admins can update anyone. Passwords set by admins have to be changed by their users.
 func (s *UserService) Update(ctx context.Context, request *user_service.UpdateRequest) (*user_service.UpdateResponse, error) {
-	panic("implement me")
+	if request.User == nil || request.User.Uuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide user")
+	}
+	claims, err := authorizeUser(ctx, request.User.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := map[string]bool{}
+	if request.UpdateMask != nil && len(request.UpdateMask.Paths) > 0 {
+		for _, path := range request.UpdateMask.Paths {
+			if path != "username" && path != "password" && path != "privateRole" {
+				return nil, status.Errorf(codes.InvalidArgument, "can't update %s", path)
+			}
+			paths[path] = true
+		}
+	} else {
+		paths["username"] = request.User.Username != ""
+		paths["password"] = request.User.Password != ""
+	}
+
+	user, err := s.userRepository.GetByUuid(ctx, request.User.Uuid)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, validation.NoUserFound)
+		}
+		return nil, err
+	}
+
+	if paths["username"] {
+		if len(request.User.Username) < 6 {
+			return nil, status.Error(codes.InvalidArgument, "Minimum username length is 6.")
+		}
+		user.Username = request.User.Username
+	}
+
+	roleChanged := false
+	if paths["privateRole"] {
+		role := model.PrivateRole(request.User.PrivateRole)
+		if claims.PrivateRole != model.Admin {
+			return nil, status.Error(codes.PermissionDenied, "admin role required to change roles")
+		}
+		if role != model.Default && role != model.Admin {
+			return nil, status.Error(codes.InvalidArgument, "unknown role")
+		}
+		if claims.Uuid == request.User.Uuid && role != model.Admin {
+			return nil, status.Error(codes.FailedPrecondition, "admins can't remove their own admin role")
+		}
+		roleChanged = user.PrivateRole != role
+		user.PrivateRole = role
+	}
+
+	res := &user_service.UpdateResponse{}
+	if paths["password"] {
+		if claims.Uuid == request.User.Uuid {
+			return nil, status.Error(codes.FailedPrecondition, "change your own password with ChangePassword")
+		}
+
+		password := request.User.Password
+		if password == "" {
+			password, err = s.passwordPolicy.Generate()
+			if err != nil {
+				return nil, err
+			}
+			res.Password = password
+		} else if err = s.passwordPolicy.Validate(password, user.Username); err != nil {
+			return nil, err
+		}
+
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), 10)
+		if err != nil {
+			return nil, err
+		}
+		user.Password = string(hashedPassword)
+		user.MustChangePassword = true
+	}
+
+	err = s.userRepository.Update(ctx, user)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok && pgErr.Code == uniqueViolation {
+			return nil, status.Error(codes.AlreadyExists, validation.UsernameTaken)
+		}
+		return nil, err
+	}
+
+	// a new password or role logs the user out of their sessions, whose access tokens carry the
+	// old role. A new password also revokes their API keys, which could otherwise be used without
+	// changing the password chosen by the admin.
+	if paths["password"] || roleChanged {
+		if err = s.sessionService.RevokeAll(ctx, request.User.Uuid, ""); err != nil {
+			return nil, err
+		}
+	}
+	if paths["password"] {
+		if err = s.apiKeyService.RevokeAll(ctx, request.User.Uuid); err != nil {
+			return nil, err
+		}
+	}
+
+	res.User = user.ToProto()
+
+	return res, nil
 }
 
+// ChangePassword replaces the password of the request's user after verifying the current one,
+// and logs the user out of their other sessions. Wrong passwords count as failed logins.
+func (s *UserService) ChangePassword(ctx context.Context, request *user_service.ChangePasswordRequest) (*user_service.ChangePasswordResponse, error) {
+	claims, err := sessionClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.OldPassword == "" || request.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide old and new password")
+	}
+
+	user, err := s.userRepository.GetByUuid(ctx, claims.Uuid)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, status.Error(codes.NotFound, validation.NoUserFound)
+		}
+		return nil, err
+	}
+
+	attempt, err := s.loginGuard.Check(ctx, user.Username)
+	if err != nil {
+		return nil, err
+	}
+	defer s.loginGuard.Release(ctx, attempt)
+	if err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.OldPassword)); err != nil {
+		s.loginGuard.RecordFailure(ctx, attempt)
+		return nil, status.Error(codes.InvalidArgument, "Wrong password")
+	}
+	s.loginGuard.RecordPassed(ctx, attempt)
+	if request.NewPassword == request.OldPassword {
+		return nil, status.Error(codes.InvalidArgument, "New password must differ from the old one.")
+	}
+	if err = s.passwordPolicy.Validate(request.NewPassword, user.Username); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(request.NewPassword), 10)
+	if err != nil {
+		return nil, err
+	}
+	user.Password = string(hashedPassword)
+	user.MustChangePassword = false
+	if err = s.userRepository.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	if err = s.sessionService.RevokeAll(ctx, claims.Uuid, claims.SessionUuid); err != nil {
+		return nil, err
+	}
+	token, expiresAt, err := s.sessionService.AccessToken(user, claims.SessionUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_service.ChangePasswordResponse{
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// Delete soft deletes a user, which can be done by the user or by an admin. Their sessions
+// and API keys are revoked.
 func (s *UserService) Delete(ctx context.Context, request *user_service.DeleteRequest) (*user_service.DeleteResponse, error) {
-	panic("implement me")
+	if request.Uuid == "" {
+		return nil, status.Error(codes.InvalidArgument, "provide uuid")
+	}
+	if _, err := authorizeUser(ctx, request.Uuid); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.userRepository.Delete(ctx, request.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, status.Error(codes.NotFound, validation.NoUserFound)
+	}
+	if err = s.sessionService.RevokeAll(ctx, request.Uuid, ""); err != nil {
+		return nil, err
+	}
+	if err = s.apiKeyService.RevokeAll(ctx, request.Uuid); err != nil {
+		return nil, err
+	}
+
+	return &user_service.DeleteResponse{}, nil
+}
+
+// authorizeUser checks that the request was made by the user with the uuid or by an admin
+func authorizeUser(ctx context.Context, uuid string) (*common.Claims, error) {
+	claims, ok := common.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "provide user token")
+	}
+	if claims.PrivateRole != model.Admin && claims.Uuid != uuid {
+		return nil, status.Error(codes.PermissionDenied, "only admins can manage other users")
+	}
+
+	return claims, nil
+}
+
+func (s *UserService) LoginMfa(ctx context.Context, request *user_service.LoginMfaRequest) (*user_service.LoginResponse, error) {
+	return s.mfaService.CompleteLogin(ctx, request)
+}
+
+func (s *UserService) GetMe(ctx context.Context, request *user_service.GetMeRequest) (*user_service.Profile, error) {
+	return s.profileService.GetMe(ctx, request)
+}
+
+func (s *UserService) UpdateMe(ctx context.Context, request *user_service.UpdateMeRequest) (*user_service.Profile, error) {
+	return s.profileService.UpdateMe(ctx, request)
+}
+
+func (s *UserService) OidcAuthorize(
+	ctx context.Context,
+	request *user_service.OidcAuthorizeRequest) (*user_service.OidcAuthorizeResponse, error) {
+	return s.oidcService.Authorize(ctx, request)
+}
+
+func (s *UserService) OidcCallback(ctx context.Context, request *user_service.OidcCallbackRequest) (*user_service.LoginResponse, error) {
+	return s.oidcService.Callback(ctx, request)
+}
+
+func (s *UserService) EnrollTotp(ctx context.Context, request *user_service.EnrollTotpRequest) (*user_service.EnrollTotpResponse, error) {
+	return s.mfaService.Enroll(ctx, request)
+}
+
+func (s *UserService) ConfirmTotp(ctx context.Context, request *user_service.ConfirmTotpRequest) (*user_service.RecoveryCodesResponse, error) {
+	return s.mfaService.Confirm(ctx, request)
+}
+
+func (s *UserService) DisableTotp(ctx context.Context, request *user_service.DisableTotpRequest) (*user_service.DisableTotpResponse, error) {
+	return s.mfaService.Disable(ctx, request)
+}
+
+func (s *UserService) RegenerateRecoveryCodes(
+	ctx context.Context,
+	request *user_service.RegenerateRecoveryCodesRequest) (*user_service.RecoveryCodesResponse, error) {
+	return s.mfaService.RegenerateRecoveryCodes(ctx, request)
+}
+
+func (s *UserService) Refresh(ctx context.Context, request *user_service.RefreshRequest) (*user_service.RefreshResponse, error) {
+	return s.sessionService.Refresh(ctx, request)
+}
+
+func (s *UserService) Logout(ctx context.Context, request *user_service.LogoutRequest) (*user_service.LogoutResponse, error) {
+	return s.sessionService.Logout(ctx, request)
+}
+
+func (s *UserService) Sessions(ctx context.Context, request *user_service.SessionsRequest) (*user_service.SessionsResponse, error) {
+	return s.sessionService.Sessions(ctx, request)
+}
+
+func (s *UserService) CreateApiKey(ctx context.Context, request *user_service.CreateApiKeyRequest) (*user_service.CreateApiKeyResponse, error) {
+	return s.apiKeyService.Create(ctx, request)
+}
+
+func (s *UserService) ListApiKeys(ctx context.Context, request *user_service.ListApiKeysRequest) (*user_service.ListApiKeysResponse, error) {
+	return s.apiKeyService.List(ctx, request)
+}
+
+func (s *UserService) RevokeApiKey(ctx context.Context, request *user_service.RevokeApiKeyRequest) (*user_service.RevokeApiKeyResponse, error) {
+	return s.apiKeyService.Revoke(ctx, request)
+}
+
+func (s *UserService) Unlock(ctx context.Context, request *user_service.UnlockRequest) (*user_service.UnlockResponse, error) {
+	return s.loginGuard.Unlock(ctx, request)
+}
+
+func (s *UserService) LoginAttempts(ctx context.Context, request *user_service.LoginAttemptsRequest) (*user_service.LoginAttemptsResponse, error) {
+	return s.loginGuard.Attempts(ctx, request)
+}
+
+// Jwks returns the public keys user tokens can be verified with
+func (s *UserService) Jwks(ctx context.Context, request *user_service.JwksRequest) (*user_service.JwksResponse, error) {
+	return s.tokenService.Jwks(), nil
 }
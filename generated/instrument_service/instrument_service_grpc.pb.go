@@ -24,6 +24,23 @@ type InstrumentServiceClient interface {
 	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
 	Chart(ctx context.Context, in *ChartRequest, opts ...grpc.CallOption) (*ChartResponse, error)
 	UpdateAllJob(ctx context.Context, in *StartUpdateJobRequest, opts ...grpc.CallOption) (*StartUpdateJobResponse, error)
+	Revisions(ctx context.Context, in *InstrumentRequest, opts ...grpc.CallOption) (*RevisionsResponse, error)
+	UploadInstruments(ctx context.Context, in *UploadInstrumentsRequest, opts ...grpc.CallOption) (*UploadInstrumentsResponse, error)
+	ListMarkets(ctx context.Context, in *ListMarketsRequest, opts ...grpc.CallOption) (*ListMarketsResponse, error)
+	CreateMarket(ctx context.Context, in *Market, opts ...grpc.CallOption) (*Market, error)
+	UpdateMarket(ctx context.Context, in *Market, opts ...grpc.CallOption) (*Market, error)
+	DeleteMarket(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*DeleteMarketResponse, error)
+	SetTickerMapping(ctx context.Context, in *TickerMapping, opts ...grpc.CallOption) (*TickerMapping, error)
+	MappingFailures(ctx context.Context, in *MappingFailuresRequest, opts ...grpc.CallOption) (*MappingFailuresResponse, error)
+	Listings(ctx context.Context, in *InstrumentRequest, opts ...grpc.CallOption) (*ListingsResponse, error)
+	SetPrimaryListing(ctx context.Context, in *SetPrimaryListingRequest, opts ...grpc.CallOption) (*Security, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	ListAll(ctx context.Context, in *ListAllRequest, opts ...grpc.CallOption) (InstrumentService_ListAllClient, error)
+	BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error)
+	Fundamentals(ctx context.Context, in *FundamentalsRequest, opts ...grpc.CallOption) (*FundamentalsResponse, error)
+	ScreenFundamentals(ctx context.Context, in *ScreenFundamentalsRequest, opts ...grpc.CallOption) (*ScreenFundamentalsResponse, error)
+	ProviderQuotas(ctx context.Context, in *ProviderQuotasRequest, opts ...grpc.CallOption) (*ProviderQuotasResponse, error)
+	Peers(ctx context.Context, in *PeersRequest, opts ...grpc.CallOption) (*PeersResponse, error)
 }
 
 type instrumentServiceClient struct {
@@ -97,6 +114,182 @@ func (c *instrumentServiceClient) UpdateAllJob(ctx context.Context, in *StartUpd
 	return out, nil
 }
 
+func (c *instrumentServiceClient) Revisions(ctx context.Context, in *InstrumentRequest, opts ...grpc.CallOption) (*RevisionsResponse, error) {
+	out := new(RevisionsResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/Revisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) UploadInstruments(ctx context.Context, in *UploadInstrumentsRequest, opts ...grpc.CallOption) (*UploadInstrumentsResponse, error) {
+	out := new(UploadInstrumentsResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/UploadInstruments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) ListMarkets(ctx context.Context, in *ListMarketsRequest, opts ...grpc.CallOption) (*ListMarketsResponse, error) {
+	out := new(ListMarketsResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/ListMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) CreateMarket(ctx context.Context, in *Market, opts ...grpc.CallOption) (*Market, error) {
+	out := new(Market)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/CreateMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) UpdateMarket(ctx context.Context, in *Market, opts ...grpc.CallOption) (*Market, error) {
+	out := new(Market)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/UpdateMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) DeleteMarket(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*DeleteMarketResponse, error) {
+	out := new(DeleteMarketResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/DeleteMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) SetTickerMapping(ctx context.Context, in *TickerMapping, opts ...grpc.CallOption) (*TickerMapping, error) {
+	out := new(TickerMapping)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/SetTickerMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) MappingFailures(ctx context.Context, in *MappingFailuresRequest, opts ...grpc.CallOption) (*MappingFailuresResponse, error) {
+	out := new(MappingFailuresResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/MappingFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) Listings(ctx context.Context, in *InstrumentRequest, opts ...grpc.CallOption) (*ListingsResponse, error) {
+	out := new(ListingsResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/Listings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) SetPrimaryListing(ctx context.Context, in *SetPrimaryListingRequest, opts ...grpc.CallOption) (*Security, error) {
+	out := new(Security)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/SetPrimaryListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) ListAll(ctx context.Context, in *ListAllRequest, opts ...grpc.CallOption) (InstrumentService_ListAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_InstrumentService_serviceDesc.Streams[0], "/v1.instrument_service.InstrumentService/ListAll", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &instrumentServiceListAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InstrumentService_ListAllClient interface {
+	Recv() (*Instrument, error)
+	grpc.ClientStream
+}
+
+type instrumentServiceListAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *instrumentServiceListAllClient) Recv() (*Instrument, error) {
+	m := new(Instrument)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *instrumentServiceClient) BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error) {
+	out := new(BatchGetResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) Fundamentals(ctx context.Context, in *FundamentalsRequest, opts ...grpc.CallOption) (*FundamentalsResponse, error) {
+	out := new(FundamentalsResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/Fundamentals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) ScreenFundamentals(ctx context.Context, in *ScreenFundamentalsRequest, opts ...grpc.CallOption) (*ScreenFundamentalsResponse, error) {
+	out := new(ScreenFundamentalsResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/ScreenFundamentals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) ProviderQuotas(ctx context.Context, in *ProviderQuotasRequest, opts ...grpc.CallOption) (*ProviderQuotasResponse, error) {
+	out := new(ProviderQuotasResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/ProviderQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) Peers(ctx context.Context, in *PeersRequest, opts ...grpc.CallOption) (*PeersResponse, error) {
+	out := new(PeersResponse)
+	err := c.cc.Invoke(ctx, "/v1.instrument_service.InstrumentService/Peers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstrumentServiceServer is the server API for InstrumentService service.
 // All implementations must embed UnimplementedInstrumentServiceServer
 // for forward compatibility
@@ -108,6 +301,23 @@ type InstrumentServiceServer interface {
 	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
 	Chart(context.Context, *ChartRequest) (*ChartResponse, error)
 	UpdateAllJob(context.Context, *StartUpdateJobRequest) (*StartUpdateJobResponse, error)
+	Revisions(context.Context, *InstrumentRequest) (*RevisionsResponse, error)
+	UploadInstruments(context.Context, *UploadInstrumentsRequest) (*UploadInstrumentsResponse, error)
+	ListMarkets(context.Context, *ListMarketsRequest) (*ListMarketsResponse, error)
+	CreateMarket(context.Context, *Market) (*Market, error)
+	UpdateMarket(context.Context, *Market) (*Market, error)
+	DeleteMarket(context.Context, *MarketRequest) (*DeleteMarketResponse, error)
+	SetTickerMapping(context.Context, *TickerMapping) (*TickerMapping, error)
+	MappingFailures(context.Context, *MappingFailuresRequest) (*MappingFailuresResponse, error)
+	Listings(context.Context, *InstrumentRequest) (*ListingsResponse, error)
+	SetPrimaryListing(context.Context, *SetPrimaryListingRequest) (*Security, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	ListAll(*ListAllRequest, InstrumentService_ListAllServer) error
+	BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error)
+	Fundamentals(context.Context, *FundamentalsRequest) (*FundamentalsResponse, error)
+	ScreenFundamentals(context.Context, *ScreenFundamentalsRequest) (*ScreenFundamentalsResponse, error)
+	ProviderQuotas(context.Context, *ProviderQuotasRequest) (*ProviderQuotasResponse, error)
+	Peers(context.Context, *PeersRequest) (*PeersResponse, error)
 	mustEmbedUnimplementedInstrumentServiceServer()
 }
 
@@ -136,6 +346,57 @@ func (UnimplementedInstrumentServiceServer) Chart(context.Context, *ChartRequest
 func (UnimplementedInstrumentServiceServer) UpdateAllJob(context.Context, *StartUpdateJobRequest) (*StartUpdateJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllJob not implemented")
 }
+func (UnimplementedInstrumentServiceServer) Revisions(context.Context, *InstrumentRequest) (*RevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revisions not implemented")
+}
+func (UnimplementedInstrumentServiceServer) UploadInstruments(context.Context, *UploadInstrumentsRequest) (*UploadInstrumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadInstruments not implemented")
+}
+func (UnimplementedInstrumentServiceServer) ListMarkets(context.Context, *ListMarketsRequest) (*ListMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMarkets not implemented")
+}
+func (UnimplementedInstrumentServiceServer) CreateMarket(context.Context, *Market) (*Market, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMarket not implemented")
+}
+func (UnimplementedInstrumentServiceServer) UpdateMarket(context.Context, *Market) (*Market, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarket not implemented")
+}
+func (UnimplementedInstrumentServiceServer) DeleteMarket(context.Context, *MarketRequest) (*DeleteMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMarket not implemented")
+}
+func (UnimplementedInstrumentServiceServer) SetTickerMapping(context.Context, *TickerMapping) (*TickerMapping, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTickerMapping not implemented")
+}
+func (UnimplementedInstrumentServiceServer) MappingFailures(context.Context, *MappingFailuresRequest) (*MappingFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MappingFailures not implemented")
+}
+func (UnimplementedInstrumentServiceServer) Listings(context.Context, *InstrumentRequest) (*ListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Listings not implemented")
+}
+func (UnimplementedInstrumentServiceServer) SetPrimaryListing(context.Context, *SetPrimaryListingRequest) (*Security, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryListing not implemented")
+}
+func (UnimplementedInstrumentServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedInstrumentServiceServer) ListAll(*ListAllRequest, InstrumentService_ListAllServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAll not implemented")
+}
+func (UnimplementedInstrumentServiceServer) BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedInstrumentServiceServer) Fundamentals(context.Context, *FundamentalsRequest) (*FundamentalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fundamentals not implemented")
+}
+func (UnimplementedInstrumentServiceServer) ScreenFundamentals(context.Context, *ScreenFundamentalsRequest) (*ScreenFundamentalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreenFundamentals not implemented")
+}
+func (UnimplementedInstrumentServiceServer) ProviderQuotas(context.Context, *ProviderQuotasRequest) (*ProviderQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderQuotas not implemented")
+}
+func (UnimplementedInstrumentServiceServer) Peers(context.Context, *PeersRequest) (*PeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Peers not implemented")
+}
 func (UnimplementedInstrumentServiceServer) mustEmbedUnimplementedInstrumentServiceServer() {}
 
 // UnsafeInstrumentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -275,6 +536,315 @@ func _InstrumentService_UpdateAllJob_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstrumentService_Revisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).Revisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/Revisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).Revisions(ctx, req.(*InstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_UploadInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadInstrumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).UploadInstruments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/UploadInstruments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).UploadInstruments(ctx, req.(*UploadInstrumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_ListMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).ListMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/ListMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).ListMarkets(ctx, req.(*ListMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_CreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Market)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).CreateMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/CreateMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).CreateMarket(ctx, req.(*Market))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_UpdateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Market)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).UpdateMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/UpdateMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).UpdateMarket(ctx, req.(*Market))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_DeleteMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).DeleteMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/DeleteMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).DeleteMarket(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_SetTickerMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickerMapping)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).SetTickerMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/SetTickerMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).SetTickerMapping(ctx, req.(*TickerMapping))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_MappingFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MappingFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).MappingFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/MappingFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).MappingFailures(ctx, req.(*MappingFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_Listings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).Listings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/Listings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).Listings(ctx, req.(*InstrumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_SetPrimaryListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).SetPrimaryListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/SetPrimaryListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).SetPrimaryListing(ctx, req.(*SetPrimaryListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_ListAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAllRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InstrumentServiceServer).ListAll(m, &instrumentServiceListAllServer{stream})
+}
+
+type InstrumentService_ListAllServer interface {
+	Send(*Instrument) error
+	grpc.ServerStream
+}
+
+type instrumentServiceListAllServer struct {
+	grpc.ServerStream
+}
+
+func (x *instrumentServiceListAllServer) Send(m *Instrument) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _InstrumentService_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).BatchGet(ctx, req.(*BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_Fundamentals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundamentalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).Fundamentals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/Fundamentals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).Fundamentals(ctx, req.(*FundamentalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_ScreenFundamentals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenFundamentalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).ScreenFundamentals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/ScreenFundamentals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).ScreenFundamentals(ctx, req.(*ScreenFundamentalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_ProviderQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).ProviderQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/ProviderQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).ProviderQuotas(ctx, req.(*ProviderQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_Peers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).Peers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.instrument_service.InstrumentService/Peers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).Peers(ctx, req.(*PeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _InstrumentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.instrument_service.InstrumentService",
 	HandlerType: (*InstrumentServiceServer)(nil),
@@ -307,7 +877,77 @@ var _InstrumentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAllJob",
 			Handler:    _InstrumentService_UpdateAllJob_Handler,
 		},
+		{
+			MethodName: "Revisions",
+			Handler:    _InstrumentService_Revisions_Handler,
+		},
+		{
+			MethodName: "UploadInstruments",
+			Handler:    _InstrumentService_UploadInstruments_Handler,
+		},
+		{
+			MethodName: "ListMarkets",
+			Handler:    _InstrumentService_ListMarkets_Handler,
+		},
+		{
+			MethodName: "CreateMarket",
+			Handler:    _InstrumentService_CreateMarket_Handler,
+		},
+		{
+			MethodName: "UpdateMarket",
+			Handler:    _InstrumentService_UpdateMarket_Handler,
+		},
+		{
+			MethodName: "DeleteMarket",
+			Handler:    _InstrumentService_DeleteMarket_Handler,
+		},
+		{
+			MethodName: "SetTickerMapping",
+			Handler:    _InstrumentService_SetTickerMapping_Handler,
+		},
+		{
+			MethodName: "MappingFailures",
+			Handler:    _InstrumentService_MappingFailures_Handler,
+		},
+		{
+			MethodName: "Listings",
+			Handler:    _InstrumentService_Listings_Handler,
+		},
+		{
+			MethodName: "SetPrimaryListing",
+			Handler:    _InstrumentService_SetPrimaryListing_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _InstrumentService_Search_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _InstrumentService_BatchGet_Handler,
+		},
+		{
+			MethodName: "Fundamentals",
+			Handler:    _InstrumentService_Fundamentals_Handler,
+		},
+		{
+			MethodName: "ScreenFundamentals",
+			Handler:    _InstrumentService_ScreenFundamentals_Handler,
+		},
+		{
+			MethodName: "ProviderQuotas",
+			Handler:    _InstrumentService_ProviderQuotas_Handler,
+		},
+		{
+			MethodName: "Peers",
+			Handler:    _InstrumentService_Peers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListAll",
+			Handler:       _InstrumentService_ListAll_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "instrument_service.proto",
 }
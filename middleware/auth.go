@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/vectorman1/analysis/analysis-api/common"
+	userModel "github.com/vectorman1/analysis/analysis-api/domain/user/model"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ApiKeyHeader is the metadata key API keys are sent in, forwarded by the gateway from the X-Api-Key header
+const ApiKeyHeader = "x-api-key"
+
+// TokenValidator parses a bearer token and returns its claims if it is valid
+type TokenValidator interface {
+	Validate(ctx context.Context, token string) (*common.Claims, error)
+}
+
+// ApiKeyValidator returns the claims of the user of an API key if it is valid
+type ApiKeyValidator interface {
+	ValidateApiKey(ctx context.Context, key string) (*common.Claims, error)
+}
+
+// RevocationList reports the sessions which were logged out, whose tokens are rejected until they expire
+type RevocationList interface {
+	IsRevoked(sessionUuid string) bool
+}
+
+// Authenticator authenticates requests by their bearer token or API key
+type Authenticator struct {
+	tokens      TokenValidator
+	apiKeys     ApiKeyValidator
+	revocations RevocationList
+}
+
+func NewAuthenticator(tokens TokenValidator, apiKeys ApiKeyValidator, revocations RevocationList) *Authenticator {
+	return &Authenticator{
+		tokens:      tokens,
+		apiKeys:     apiKeys,
+		revocations: revocations,
+	}
+}
+
+// authenticate returns the claims of the request's API key, or of its bearer token if it has no key
+func (a *Authenticator) authenticate(ctx context.Context) (*common.Claims, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get(ApiKeyHeader); len(keys) > 0 && keys[0] != "" {
+		claims, err := a.apiKeys.ValidateApiKey(ctx, keys[0])
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+		return claims, nil
+	}
+
+	token, err := grpc_auth.AuthFromMD(ctx, "Bearer")
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "provide user token or api key")
+	}
+
+	claims, err := a.tokens.Validate(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid user token")
+	}
+	if claims.SessionUuid != "" && a.revocations.IsRevoked(claims.SessionUuid) {
+		return nil, status.Error(codes.Unauthenticated, "session was revoked")
+	}
+
+	return claims, nil
+}
+
+// authorize checks the credentials of the request against the policy of the method and
+// returns a context holding its claims. Invalid credentials are ignored on public methods.
+func (a *Authenticator) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	policy := methodPolicy(fullMethod)
+
+	claims, err := a.authenticate(ctx)
+	if err != nil {
+		if policy == Public {
+			return ctx, nil
+		}
+		return nil, err
+	}
+	if claims.MustChangePassword && !passwordChangeMethods[fullMethod] {
+		return nil, status.Error(codes.PermissionDenied, "password change required")
+	}
+	if claims.TotpEnrollmentRequired && !totpEnrollmentMethods[fullMethod] {
+		return nil, status.Error(codes.PermissionDenied, "two-factor authentication enrollment required")
+	}
+	if claims.ReadOnly && !readOnlyMethods[fullMethod] {
+		return nil, status.Error(codes.PermissionDenied, "api key is read-only")
+	}
+	if policy == Admin && claims.PrivateRole != userModel.Admin {
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	}
+
+	return common.ContextWithClaims(ctx, claims), nil
+}
+
+func authUnaryInterceptor(auth *Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		newCtx, err := auth.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(newCtx, req)
+	}
+}
+
+func authStreamInterceptor(auth *Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		newCtx, err := auth.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = newCtx
+		return handler(srv, wrapped)
+	}
+}
@@ -1,13 +1,7 @@
 package middleware
 
 import (
-	"context"
-
-	"github.com/dgrijalva/jwt-go"
-	"github.com/vectorman1/analysis/analysis-api/common"
-
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"go.uber.org/zap"
@@ -27,7 +21,7 @@ func codeToLevel(code codes.Code) zapcore.Level {
 }
 
 // LoadMiddleware returns grpc.Server config option with loaded middlewares
-func LoadMiddleware(logger *zap.Logger, opts []grpc.ServerOption) []grpc.ServerOption {
+func LoadMiddleware(logger *zap.Logger, auth *Authenticator, opts []grpc.ServerOption) []grpc.ServerOption {
 	// Shared options for the logger-grpc, with a custom gRPC code to log level function.
 	o := []grpc_zap.Option{
 		grpc_zap.WithLevels(codeToLevel),
@@ -36,39 +30,20 @@ func LoadMiddleware(logger *zap.Logger, opts []grpc.ServerOption) []grpc.ServerO
 	// Make sure that log statements internal to gRPC library are logged using the zapLogger as well.
 	grpc_zap.ReplaceGrpcLoggerV2(logger)
 
-	// Add authentication
+	// Add authentication and authorization by the method policies
 	// Add unary interceptor
 	opts = append(opts, grpc_middleware.WithUnaryServerChain(
-		grpc_auth.UnaryServerInterceptor(authorizeToken),
+		authUnaryInterceptor(auth),
 		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
 		grpc_zap.UnaryServerInterceptor(logger, o...),
 	))
 
 	// Add stream interceptor
 	opts = append(opts, grpc_middleware.WithStreamServerChain(
+		authStreamInterceptor(auth),
 		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
 		grpc_zap.StreamServerInterceptor(logger, o...),
 	))
 
 	return opts
 }
-
-// authorizeToken parses the token and stores the user info in the request context
-func authorizeToken(ctx context.Context) (context.Context, error) {
-	token, err := grpc_auth.AuthFromMD(ctx, "Bearer")
-	if err != nil {
-		return ctx, nil
-	}
-
-	claims := &common.Claims{}
-	_, err = jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte("kurzaushev"), nil
-	})
-	if err != nil {
-		return ctx, nil
-	}
-
-	newCtx := context.WithValue(ctx, "user_info", claims)
-
-	return newCtx, nil
-}
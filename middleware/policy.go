@@ -0,0 +1,127 @@
+package middleware
+
+// Policy is the access required to call an RPC
+type Policy int
+
+const (
+	// Public methods can be called without a token
+	Public Policy = iota
+	// Authenticated methods require a valid token
+	Authenticated
+	// Admin methods require a valid token of a user with the admin role
+	Admin
+)
+
+const (
+	instrumentService = "/v1.instrument_service.InstrumentService/"
+	userService       = "/v1.user_service.UserService/"
+)
+
+// methodPolicies holds the policy of every RPC. Methods missing from it require the admin role,
+// so new RPCs aren't exposed by accident. Checks which depend on the request, e.g. users
+// managing only themselves, are left to the services.
+var methodPolicies = map[string]Policy{
+	instrumentService + "GetPaged":           Public,
+	instrumentService + "Get":                Public,
+	instrumentService + "ListAll":            Public,
+	instrumentService + "BatchGet":           Public,
+	instrumentService + "Search":             Public,
+	instrumentService + "Listings":           Public,
+	instrumentService + "Revisions":          Public,
+	instrumentService + "History":            Public,
+	instrumentService + "Chart":              Public,
+	instrumentService + "ListMarkets":        Public,
+	instrumentService + "Overview":           Authenticated,
+	instrumentService + "Fundamentals":       Authenticated,
+	instrumentService + "ScreenFundamentals": Authenticated,
+	instrumentService + "Peers":              Authenticated,
+	instrumentService + "UpdateAll":          Admin,
+	instrumentService + "UpdateAllJob":       Admin,
+	instrumentService + "UploadInstruments":  Admin,
+	instrumentService + "CreateMarket":       Admin,
+	instrumentService + "UpdateMarket":       Admin,
+	instrumentService + "DeleteMarket":       Admin,
+	instrumentService + "SetTickerMapping":   Admin,
+	instrumentService + "MappingFailures":    Admin,
+	instrumentService + "SetPrimaryListing":  Admin,
+	instrumentService + "ProviderQuotas":     Admin,
+
+	userService + "Login":                   Public,
+	userService + "Register":                Public,
+	userService + "LoginMfa":                Public,
+	userService + "OidcAuthorize":           Public,
+	userService + "OidcCallback":            Public,
+	userService + "Refresh":                 Public,
+	userService + "Jwks":                    Public,
+	userService + "Get":                     Authenticated,
+	userService + "Update":                  Authenticated,
+	userService + "Delete":                  Authenticated,
+	userService + "ChangePassword":          Authenticated,
+	userService + "GetMe":                   Authenticated,
+	userService + "UpdateMe":                Authenticated,
+	userService + "Logout":                  Authenticated,
+	userService + "Sessions":                Authenticated,
+	userService + "CreateApiKey":            Authenticated,
+	userService + "ListApiKeys":             Authenticated,
+	userService + "RevokeApiKey":            Authenticated,
+	userService + "EnrollTotp":              Authenticated,
+	userService + "ConfirmTotp":             Authenticated,
+	userService + "DisableTotp":             Authenticated,
+	userService + "RegenerateRecoveryCodes": Authenticated,
+	userService + "GetPaged":                Admin,
+	userService + "Create":                  Admin,
+	userService + "Unlock":                  Admin,
+	userService + "LoginAttempts":           Admin,
+}
+
+// readOnlyMethods are the methods read-only API keys can call, within the policy of the method
+var readOnlyMethods = map[string]bool{
+	instrumentService + "GetPaged":           true,
+	instrumentService + "Get":                true,
+	instrumentService + "ListAll":            true,
+	instrumentService + "BatchGet":           true,
+	instrumentService + "Search":             true,
+	instrumentService + "Listings":           true,
+	instrumentService + "Revisions":          true,
+	instrumentService + "History":            true,
+	instrumentService + "Chart":              true,
+	instrumentService + "ListMarkets":        true,
+	instrumentService + "Overview":           true,
+	instrumentService + "Fundamentals":       true,
+	instrumentService + "ScreenFundamentals": true,
+	instrumentService + "Peers":              true,
+	instrumentService + "MappingFailures":    true,
+	instrumentService + "ProviderQuotas":     true,
+
+	userService + "Get":           true,
+	userService + "GetPaged":      true,
+	userService + "GetMe":         true,
+	userService + "ListApiKeys":   true,
+	userService + "LoginAttempts": true,
+}
+
+func methodPolicy(fullMethod string) Policy {
+	if policy, ok := methodPolicies[fullMethod]; ok {
+		return policy
+	}
+
+	return Admin
+}
+
+// passwordChangeMethods are the methods users who have to change their password can call
+var passwordChangeMethods = map[string]bool{
+	userService + "ChangePassword": true,
+	userService + "Get":            true,
+	userService + "Logout":         true,
+	userService + "Sessions":       true,
+}
+
+// totpEnrollmentMethods are the methods admins who have to enroll TOTP can call
+var totpEnrollmentMethods = map[string]bool{
+	userService + "EnrollTotp":     true,
+	userService + "ConfirmTotp":    true,
+	userService + "ChangePassword": true,
+	userService + "Get":            true,
+	userService + "Logout":         true,
+	userService + "Sessions":       true,
+}
@@ -21,6 +21,7 @@ import (
 type GRPCServer struct {
 	Context             context.Context
 	Port                string
+	authenticator       *middleware.Authenticator
 	symbolServiceServer *instrument_present.InstrumentServiceServer
 	userServiceServer   *user_present.UserServiceServer
 }
@@ -28,11 +29,13 @@ type GRPCServer struct {
 func NewGRPCServer(
 	ctx context.Context,
 	port string,
+	authenticator *middleware.Authenticator,
 	instrumentServiceServer *instrument_present.InstrumentServiceServer,
 	userServiceServer *user_present.UserServiceServer) *GRPCServer {
 	return &GRPCServer{
 		Context:             ctx,
 		Port:                port,
+		authenticator:       authenticator,
 		symbolServiceServer: instrumentServiceServer,
 		userServiceServer:   userServiceServer,
 	}
@@ -46,7 +49,7 @@ func (s *GRPCServer) Run() error {
 	}
 
 	// add middleware
-	opts := middleware.LoadMiddleware(logger_grpc.Log, nil)
+	opts := middleware.LoadMiddleware(logger_grpc.Log, s.authenticator, nil)
 
 	server := grpc.NewServer(opts...)
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/vectorman1/analysis/analysis-api/generated/user_service"
 
+	"github.com/vectorman1/analysis/analysis-api/middleware"
 	logger_rest "github.com/vectorman1/analysis/analysis-api/middleware/logger-rest"
 
 	"log"
@@ -23,14 +24,22 @@ import (
 	tracer_rest "github.com/vectorman1/analysis/analysis-api/middleware/tracer-rest"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
+// oidcBindingLifetime is how long the login binding cookie is kept, as long as logins can be completed
+const oidcBindingLifetime = 10 * time.Minute
+
 // RunServer runs HTTP/REST gateway
 func RunServer(ctx context.Context, config *common.Config) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	gwmux := runtime.NewServeMux()
+	gwmux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithForwardResponseOption(oidcBindingCookie(strings.HasPrefix(config.OidcRedirectUrl, "https://"))),
+		runtime.WithForwardResponseOption(oidcRedirect))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 
 	if err := instrument_service.RegisterInstrumentServiceHandlerFromEndpoint(ctx, gwmux, "0.0.0.0:"+config.GRPCPort, opts); err != nil {
@@ -44,12 +53,12 @@ func RunServer(ctx context.Context, config *common.Config) error {
 	if config.AllowedOrigin == "*" {
 		srv = &http.Server{
 			Addr:    "0.0.0.0:" + config.HTTPPort,
-			Handler: tracer_rest.AddRequestID(logger_rest.AddLogger(logger_grpc.Log, allowCORS(gwmux, config.AllowedOrigin))),
+			Handler: tracer_rest.AddRequestID(logger_rest.AddLogger(logger_grpc.Log, allowCORS(dropRedirectBody(gwmux), config.AllowedOrigin))),
 		}
 	} else {
 		srv = &http.Server{
 			Addr:    "0.0.0.0:" + config.HTTPPort,
-			Handler: tracer_rest.AddRequestID(logger_rest.AddLogger(logger_grpc.Log, gwmux)),
+			Handler: tracer_rest.AddRequestID(logger_rest.AddLogger(logger_grpc.Log, dropRedirectBody(gwmux))),
 		}
 	}
 
@@ -71,6 +80,120 @@ func RunServer(ctx context.Context, config *common.Config) error {
 	return srv.ListenAndServe()
 }
 
+// RunMetricsServer serves the metrics on their own port, which isn't exposed through the gateway
+func RunMetricsServer(ctx context.Context, config *common.Config, metrics http.Handler) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+	srv := &http.Server{
+		Addr:    "0.0.0.0:" + config.MetricsPort,
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = srv.Shutdown(context.Background())
+	}()
+
+	log.Println("starting metrics server...")
+	return srv.ListenAndServe()
+}
+
+// incomingHeaderMatcher forwards the API key header to the gRPC server along with the default headers
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, middleware.ApiKeyHeader) {
+		return middleware.ApiKeyHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher keeps the login binding of OpenID Connect logins out of the response headers,
+// as it is only set as a cookie
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, common.OidcBindingMetadata) {
+		return "", false
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// oidcBindingCookie sets the cookie binding OpenID Connect logins to the browser which started them,
+// or clears it when the login's callback took it
+func oidcBindingCookie(secure bool) func(context.Context, http.ResponseWriter, proto.Message) error {
+	return func(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+		md, ok := runtime.ServerMetadataFromContext(ctx)
+		if !ok {
+			return nil
+		}
+		values := md.HeaderMD.Get(common.OidcBindingMetadata)
+		if len(values) == 0 {
+			return nil
+		}
+
+		cookie := &http.Cookie{
+			Name:     common.OidcBindingCookie,
+			Value:    values[0],
+			Path:     "/api/v1/users/oidc",
+			MaxAge:   int(oidcBindingLifetime.Seconds()),
+			Secure:   secure,
+			HttpOnly: true,
+			// lax, as the provider redirects back to the callback from another site
+			SameSite: http.SameSiteLaxMode,
+		}
+		if cookie.Value == "" {
+			cookie.MaxAge = -1
+		}
+		http.SetCookie(w, cookie)
+
+		return nil
+	}
+}
+
+// oidcRedirect redirects browsers starting an OpenID Connect login to the provider. The gateway
+// still writes the response message after, which dropRedirectBody discards.
+func oidcRedirect(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	if res, ok := resp.(*user_service.OidcAuthorizeResponse); ok {
+		w.Header().Set("Location", res.Url)
+		w.WriteHeader(http.StatusFound)
+	}
+
+	return nil
+}
+
+// dropRedirectBody discards what the gateway writes after a forward response option redirected
+// the response, so redirects have no body
+func dropRedirectBody(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(&redirectWriter{ResponseWriter: w}, r)
+	})
+}
+
+type redirectWriter struct {
+	http.ResponseWriter
+	redirected bool
+}
+
+func (w *redirectWriter) WriteHeader(statusCode int) {
+	if statusCode >= 300 && statusCode < 400 {
+		w.redirected = true
+		w.Header().Del("Content-Type")
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *redirectWriter) Write(b []byte) (int, error) {
+	if w.redirected {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *redirectWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func preflightHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Headers", strings.Join(common.GetAllowedHeaders(), ","))
 	w.Header().Set("Access-Control-Allow-Methods", strings.Join(common.GetAllowedMethods(), ","))
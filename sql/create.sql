@@ -1,4 +1,5 @@
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
 
 CREATE SCHEMA IF NOT EXISTS analysis;
 CREATE SCHEMA IF NOT EXISTS "user";
@@ -31,9 +32,106 @@ CREATE TABLE IF NOT EXISTS analysis.symbols
     marketHoursGmt TEXT NOT NULL,
     createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
     updatedAt TIMESTAMPTZ NOT NULL DEFAULT now(),
-    deletedAt TIMESTAMPTZ NULL DEFAULT NULL
+    deletedAt TIMESTAMPTZ NULL DEFAULT NULL,
+    source TEXT NOT NULL DEFAULT 'trading212'
 );
 
+ALTER TABLE analysis.symbols ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'trading212';
+
+CREATE INDEX IF NOT EXISTS symbols_isin_idx ON analysis.symbols (isin);
+CREATE INDEX IF NOT EXISTS symbols_identifier_market_idx ON analysis.symbols (identifier, marketName);
+
+-- Instrument search by full text and by trigram similarity for typo tolerance
+CREATE INDEX IF NOT EXISTS symbols_search_idx ON analysis.symbols
+    USING GIN (to_tsvector('simple', identifier || ' ' || name));
+CREATE INDEX IF NOT EXISTS symbols_identifier_trgm_idx ON analysis.symbols USING GIN (identifier gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS symbols_name_trgm_idx ON analysis.symbols USING GIN (name gin_trgm_ops);
+
+-- Securities group the listings of an instrument on different markets and currencies by ISIN
+CREATE TABLE IF NOT EXISTS analysis.securities
+(
+    id SERIAL PRIMARY KEY,
+    isin TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    primaryListingUuid uuid NULL DEFAULT NULL REFERENCES analysis.symbols (uuid),
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updatedAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO analysis.securities (isin, name)
+SELECT DISTINCT ON (isin) isin, name
+FROM analysis.symbols
+WHERE isin <> ''
+ORDER BY isin, createdAt
+ON CONFLICT (isin) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS analysis.markets
+(
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    tickerSuffix TEXT NOT NULL DEFAULT '',
+    currencyCode TEXT NOT NULL,
+    calendar TEXT NOT NULL,
+    syncEnabled BOOLEAN NOT NULL DEFAULT FALSE,
+    historyEnabled BOOLEAN NOT NULL DEFAULT FALSE,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updatedAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Markets which are synced and have their history updated
+INSERT INTO analysis.markets (name, tickerSuffix, currencyCode, calendar, syncEnabled, historyEnabled) VALUES
+    ('NASDAQ', '', 'USD', 'XNYS', TRUE, TRUE),
+    ('NYSE', '', 'USD', 'XNYS', TRUE, TRUE),
+    ('NON-ISA NYSE', '', 'USD', 'XNYS', TRUE, TRUE),
+    ('NON-ISA OTC Markets', '', 'USD', 'XNYS', TRUE, TRUE),
+    ('OTC Markets', '', 'USD', 'XNYS', FALSE, TRUE),
+    ('NON-ISA NASDAQ', '', 'USD', 'XNYS', FALSE, TRUE)
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS analysis.ticker_mappings
+(
+    id SERIAL PRIMARY KEY,
+    symbolUuid uuid NOT NULL REFERENCES analysis.symbols (uuid),
+    provider TEXT NOT NULL,
+    ticker TEXT NOT NULL,
+    source TEXT NOT NULL,
+    failed BOOLEAN NOT NULL DEFAULT FALSE,
+    error TEXT NULL DEFAULT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updatedAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (symbolUuid, provider)
+);
+
+CREATE TABLE IF NOT EXISTS analysis.provider_quota_usage
+(
+    provider TEXT NOT NULL,
+    period TEXT NOT NULL,
+    windowStart TIMESTAMPTZ NOT NULL,
+    used INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (provider, period, windowStart)
+);
+
+CREATE TABLE IF NOT EXISTS analysis.instrument_uploads
+(
+    id SERIAL PRIMARY KEY,
+    content TEXT NOT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS analysis.symbol_revisions
+(
+    id SERIAL PRIMARY KEY,
+    symbolUuid uuid NOT NULL REFERENCES analysis.symbols (uuid),
+    type SMALLINT NOT NULL,
+    name TEXT NOT NULL,
+    previousName TEXT NULL DEFAULT NULL,
+    marketHoursGmt TEXT NOT NULL,
+    previousMarketHoursGmt TEXT NULL DEFAULT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS symbol_revisions_symbol_uuid_idx ON analysis.symbol_revisions (symbolUuid, createdAt);
+
 CREATE TABLE IF NOT EXISTS "user".users
 (
     id SERIAL PRIMARY KEY,
@@ -43,8 +141,147 @@ CREATE TABLE IF NOT EXISTS "user".users
     password TEXT NOT NULL,
     createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
     updatedAt TIMESTAMPTZ NOT NULL DEFAULT now(),
-    deletedAt TIMESTAMPTZ NULL DEFAULT NULL
+    deletedAt TIMESTAMPTZ NULL DEFAULT NULL,
+    -- set for passwords chosen by admins, which the user has to change before using the API
+    mustChangePassword BOOLEAN NOT NULL DEFAULT false,
+    -- base32 TOTP secret, which is pending until the user confirms it with a code
+    totpSecret TEXT NULL DEFAULT NULL,
+    totpEnabled BOOLEAN NOT NULL DEFAULT false,
+    -- last accepted time step, so codes can't be used twice
+    totpLastStep BIGINT NOT NULL DEFAULT 0
     );
 
+ALTER TABLE "user".users ADD COLUMN IF NOT EXISTS mustChangePassword BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE "user".users ADD COLUMN IF NOT EXISTS totpSecret TEXT NULL DEFAULT NULL;
+ALTER TABLE "user".users ADD COLUMN IF NOT EXISTS totpEnabled BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE "user".users ADD COLUMN IF NOT EXISTS totpLastStep BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "user".signing_keys
+(
+    id SERIAL PRIMARY KEY,
+    kid TEXT NOT NULL UNIQUE,
+    algorithm TEXT NOT NULL,
+    -- HMAC secret or PKCS #8 private key
+    privateKey BYTEA NOT NULL,
+    -- PKIX public key, NULL for HMAC keys
+    publicKey BYTEA NULL DEFAULT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    -- retired keys no longer sign tokens, and verify them until they expire
+    retiredAt TIMESTAMPTZ NULL DEFAULT NULL,
+    expiresAt TIMESTAMPTZ NULL DEFAULT NULL
+);
+
+-- when tokens were first signed with signing keys, tokens without a kid must have been issued before
+CREATE TABLE IF NOT EXISTS "user".signing_key_rollout
+(
+    id INT PRIMARY KEY CHECK (id = 1),
+    rolledOutAt TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "user".sessions
+(
+    id SERIAL PRIMARY KEY,
+    uuid uuid NOT NULL UNIQUE,
+    userUuid uuid NOT NULL,
+    -- SHA-256 hashes of the current refresh token and of the one it replaced, which is
+    -- kept to detect reuse of rotated tokens
+    refreshTokenHash TEXT NOT NULL UNIQUE,
+    previousRefreshTokenHash TEXT NULL DEFAULT NULL,
+    userAgent TEXT NOT NULL DEFAULT '',
+    ipAddress TEXT NOT NULL DEFAULT '',
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    lastUsedAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expiresAt TIMESTAMPTZ NOT NULL,
+    revokedAt TIMESTAMPTZ NULL DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS sessions_user_uuid_idx ON "user".sessions (userUuid);
+CREATE INDEX IF NOT EXISTS sessions_previous_refresh_token_hash_idx ON "user".sessions (previousRefreshTokenHash);
+CREATE INDEX IF NOT EXISTS sessions_revoked_at_idx ON "user".sessions (revokedAt);
+
+CREATE TABLE IF NOT EXISTS "user".api_keys
+(
+    id SERIAL PRIMARY KEY,
+    uuid uuid NOT NULL UNIQUE,
+    userUuid uuid NOT NULL,
+    name TEXT NOT NULL,
+    -- prefix is the start of the key, shown to tell keys apart
+    prefix TEXT NOT NULL,
+    -- SHA-256 hash of the key
+    keyHash TEXT NOT NULL UNIQUE,
+    scope BIGINT NOT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expiresAt TIMESTAMPTZ NULL DEFAULT NULL,
+    lastUsedAt TIMESTAMPTZ NULL DEFAULT NULL,
+    revokedAt TIMESTAMPTZ NULL DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS api_keys_user_uuid_idx ON "user".api_keys (userUuid);
+
+-- audit log of logins, which also holds the failures logins are throttled and locked by
+CREATE TABLE IF NOT EXISTS "user".login_attempts
+(
+    id SERIAL PRIMARY KEY,
+    username TEXT NOT NULL,
+    ipAddress TEXT NOT NULL DEFAULT '',
+    userAgent TEXT NOT NULL DEFAULT '',
+    -- success, failure, throttled, locked, pending, passed, aborted or unlock
+    result TEXT NOT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS login_attempts_username_idx ON "user".login_attempts (username, createdAt);
+CREATE INDEX IF NOT EXISTS login_attempts_ip_address_idx ON "user".login_attempts (ipAddress, createdAt);
+
+CREATE TABLE IF NOT EXISTS "user".recovery_codes
+(
+    id SERIAL PRIMARY KEY,
+    userUuid uuid NOT NULL,
+    -- SHA-256 hash of the code
+    codeHash TEXT NOT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    usedAt TIMESTAMPTZ NULL DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS recovery_codes_user_uuid_idx ON "user".recovery_codes (userUuid);
+
+CREATE TABLE IF NOT EXISTS "user".identities
+(
+    id SERIAL PRIMARY KEY,
+    userUuid uuid NOT NULL,
+    -- issuer and subject of the OpenID Connect provider's ID tokens of the user
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    lastLoginAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (issuer, subject)
+);
+CREATE INDEX IF NOT EXISTS identities_user_uuid_idx ON "user".identities (userUuid);
+
+CREATE TABLE IF NOT EXISTS "user".oidc_logins
+(
+    id SERIAL PRIMARY KEY,
+    -- state and nonce of the authorization request, and its PKCE code verifier
+    state TEXT NOT NULL UNIQUE,
+    nonce TEXT NOT NULL,
+    codeVerifier TEXT NOT NULL,
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- SHA-256 hash of the cookie binding the login to the browser which started it
+ALTER TABLE "user".oidc_logins ADD COLUMN IF NOT EXISTS bindingHash TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS oidc_logins_created_at_idx ON "user".oidc_logins (createdAt);
+
+CREATE TABLE IF NOT EXISTS "user".profiles
+(
+    id SERIAL PRIMARY KEY,
+    userUuid uuid NOT NULL UNIQUE,
+    displayName TEXT NOT NULL DEFAULT '',
+    email TEXT NOT NULL DEFAULT '',
+    -- IANA time zone, e.g. Europe/Sofia
+    timezone TEXT NOT NULL DEFAULT '',
+    -- ISO 4217 currency code
+    defaultCurrency TEXT NOT NULL DEFAULT '',
+    defaultChartRange TEXT NOT NULL DEFAULT '',
+    preferredIndicators TEXT[] NOT NULL DEFAULT '{}',
+    createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updatedAt TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
 -- Insert admin account
 INSERT INTO "user".users VALUES (1, uuid_generate_v4(), 1, 'admin', '$2a$10$DT3TWK7tRrfdGhxY0KS9hux3PutpaU.7z1UQmn6eitfroNzwaUDMe');